@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// findDefault returns a copy of the value marked by a stringer:default
+// directive, or nil. A type has at most one, and the options relying on it
+// need one. The copy outlives splitIntoRuns reordering values in place.
+func findDefault(typeName string, kind Kind, values []Value, opts typeOptions) *Value {
+	var fallback *Value
+	for i := range values {
+		if !values[i].isDefault {
+			continue
+		}
+		if fallback != nil {
+			log.Fatalf("type %s: both %s and %s are marked stringer:default", typeName, fallback.originalName, values[i].originalName)
+		}
+		v := values[i]
+		fallback = &v
+	}
+
+	switch {
+	case fallback != nil && kind == Flag:
+		log.Fatalf("type %s: default values are only supported for enums", typeName)
+	case fallback == nil && opts.lenient:
+		log.Fatalf("type %s: lenient needs a value marked stringer:default", typeName)
+	case fallback == nil && opts.stringDefault:
+		log.Fatalf("type %s: stringDefault needs a value marked stringer:default", typeName)
+	}
+	return fallback
+}
+
+// unknownName returns the expression naming a value i of the named type
+// that no constant has: the name of the default value, or T(i).
+func (g *Generator) unknownName(typeName, i string) string {
+	if g.unknown {
+		return strconv.Quote(g.fallback.name)
+	}
+	g.use("strconv")
+	return fmt.Sprintf("\"%s(\" + strconv.FormatInt(int64(%s), 10) + \")\"", typeName, i)
+}
+
+// unknownParse returns the statement returning from a parse function given
+// a string s naming no value: the default value in lenient mode, and an
+// error listing the names in the array names otherwise.
+func (g *Generator) unknownParse(typeName, names string) string {
+	if g.lenient {
+		return fmt.Sprintf("return %s, nil", g.fallback.originalName)
+	}
+	g.use(enumPackage)
+	return fmt.Sprintf("return 0, &enum.ParseError{Type: %q, Input: s, Valid: append([]string(nil), %s[:]...), Err: enum.ErrUnknownName}", typeName, names)
+}
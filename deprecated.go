@@ -0,0 +1,122 @@
+package main
+
+import (
+	"go/ast"
+	"strings"
+)
+
+// isDeprecated reports whether the comment has a paragraph starting with
+// "Deprecated: ", following the Go convention.
+func isDeprecated(c *ast.CommentGroup) bool {
+	for _, p := range strings.Split(c.Text(), "\n\n") {
+		if strings.HasPrefix(p, "Deprecated: ") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDeprecated reports whether any of the values of runs is deprecated.
+func hasDeprecated(runs [][]Value) bool {
+	for _, values := range runs {
+		for _, v := range values {
+			if v.deprecated {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// declareDeprecated declares the function telling whether a value is
+// deprecated. For flags, it is if any of the flags set is.
+func (g *Generator) declareDeprecated(typeName string, kind Kind, runs [][]Value) {
+	if g.emitted["deprecated"] {
+		return
+	}
+	g.emitted["deprecated"] = true
+
+	var names []string
+	for _, values := range runs {
+		for _, v := range values {
+			if v.deprecated {
+				names = append(names, v.originalName)
+			}
+		}
+	}
+
+	g.Printf("\nfunc _%[1]s_deprecated(i %[1]s) bool {\n", typeName)
+	switch {
+	case len(names) == 0:
+		g.Printf("	return false\n")
+	case kind == Flag && len(names) == 1:
+		g.Printf("	return i&%s != 0\n", names[0])
+	case kind == Flag:
+		g.Printf("	return i&(%s) != 0\n", strings.Join(names, "|"))
+	default:
+		g.Printf("	switch i {\n")
+		g.Printf("	case %s:\n", strings.Join(names, ", "))
+		g.Printf("		return true\n")
+		g.Printf("	}\n")
+		g.Printf("	return false\n")
+	}
+	g.Printf("}\n")
+}
+
+// buildDeprecated generates the IsDeprecated method.
+func (g *Generator) buildDeprecated(typeName string, kind Kind, runs [][]Value) {
+	g.declareDeprecated(typeName, kind, runs)
+	if name := g.method(typeName, "IsDeprecated"); name != "" {
+		g.Printf("\n// %s reports whether i is deprecated.\n", name)
+		if kind == Flag {
+			g.Printf("// A value is if any of its flags is.\n")
+		}
+		g.Printf("func (i %s) %s() bool {\n", typeName, name)
+		g.Printf("	return _%s_deprecated(i)\n", typeName)
+		g.Printf("}\n")
+	}
+}
+
+// buildParseDeprecated generates the parse function named fn wrapping the
+// one named inner, reporting deprecated values to the handler set with
+// enum.HandleDeprecated.
+func (g *Generator) buildParseDeprecated(typeName string, kind Kind, runs [][]Value, fn, inner, doc string) {
+	g.declareDeprecated(typeName, kind, runs)
+	g.use(enumPackage)
+	g.Printf("\n%s", doc)
+	g.Printf("// Deprecated values are reported to the handler set with enum.HandleDeprecated.\n")
+	g.Printf("func %[2]s(s string) (%[1]s, error) {\n", typeName, fn)
+	g.Printf("	i, err := %s(s)\n", inner)
+	g.Printf("	if err == nil && _%s_deprecated(i) {\n", typeName)
+	g.Printf("		enum.Deprecated(%q, s)\n", typeName)
+	g.Printf("	}\n")
+	g.Printf("	return i, err\n")
+	g.Printf("}\n")
+}
+
+// buildValues generates the function returning the values of the type, in
+// increasing order. Deprecated values are left out unless all is set.
+func (g *Generator) buildValues(typeName string, runs [][]Value, all bool) {
+	fn := g.ident(typeName + "Values")
+	if fn == "" {
+		return
+	}
+
+	var names []string
+	for _, values := range runs {
+		for _, v := range values {
+			if all || !v.deprecated {
+				names = append(names, v.originalName)
+			}
+		}
+	}
+
+	g.Printf("\n// %s returns the values of %s", fn, typeName)
+	if !all && hasDeprecated(runs) {
+		g.Printf(" that are not deprecated")
+	}
+	g.Printf(".\n")
+	g.Printf("func %s() []%s {\n", fn, typeName)
+	g.Printf("	return []%s{%s}\n", typeName, strings.Join(names, ", "))
+	g.Printf("}\n")
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"go/ast"
+	"strings"
+)
+
+// docText returns the text of the comment, without directives, with the
+// lines of each paragraph joined by spaces and paragraphs separated by an
+// empty line.
+func docText(c *ast.CommentGroup) string {
+	var paragraphs []string
+	for _, p := range strings.Split(c.Text(), "\n\n") {
+		if p = strings.Join(strings.Fields(p), " "); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// buildDescription generates the Description method returning the text of
+// the doc or line comment of the constant defining a value.
+func (g *Generator) buildDescription(typeName string, kind Kind, layout Layout, runs [][]Value) {
+	g.declareOrdinal(typeName, kind, runs, layout)
+	g.declareValueTable(typeName, "description", "description_index", runs, func(v *Value) string {
+		return v.description
+	})
+
+	if name := g.method(typeName, "Description"); name != "" {
+		g.Printf("\n// %s returns the documentation of i, or \"\" for unknown values.\n", name)
+		g.Printf("func (i %s) %s() string {\n", typeName, name)
+		g.Printf("	if j := _%s_ordinal(i); j >= 0 {\n", typeName)
+		g.Printf("		return _%[1]s_description[_%[1]s_description_index[j]:_%[1]s_description_index[j+1]]\n", typeName)
+		g.Printf("	}\n")
+		g.Printf("	return \"\"\n")
+		g.Printf("}\n")
+	}
+}
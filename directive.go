@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// directivePrefix starts the comments giving directives about a constant,
+// such as //stringer:name "foo".
+const directivePrefix = "//stringer:"
+
+// directive is a comment giving a directive about a constant.
+type directive struct {
+	pos  token.Pos
+	name string // Name of the directive, following the prefix.
+	args string // Rest of the comment.
+}
+
+// isDirective reports whether the comment is a directive.
+func isDirective(c *ast.Comment) bool {
+	return strings.HasPrefix(c.Text, directivePrefix)
+}
+
+// directives returns the directives found in the comment groups, some of
+// which may be nil.
+func directives(groups ...*ast.CommentGroup) []directive {
+	var list []directive
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		for _, c := range group.List {
+			if !isDirective(c) {
+				continue
+			}
+			name, args, _ := strings.Cut(c.Text[len(directivePrefix):], " ")
+			list = append(list, directive{pos: c.Pos(), name: name, args: strings.TrimSpace(args)})
+		}
+	}
+	return list
+}
+
+// apply applies the directive to the value.
+func (d directive) apply(v *Value) error {
+	switch d.name {
+	case "name":
+		names, err := quotedList(d.args)
+		if err != nil {
+			return err
+		}
+		if len(names) != 1 {
+			return fmt.Errorf("stringer:name takes a single name")
+		}
+		v.name = names[0]
+	case "alias":
+		aliases, err := quotedList(d.args)
+		if err != nil {
+			return err
+		}
+		v.aliases = append(v.aliases, aliases...)
+	case "meta":
+		return v.applyMeta(d.args)
+	case "transitions":
+		return v.applyTransitions(d.args)
+	case "default":
+		if d.args != "" {
+			return fmt.Errorf("stringer:default takes no arguments")
+		}
+		v.isDefault = true
+	case "json", "text", "display":
+		fields, err := directiveFields(d.args)
+		if err != nil {
+			return err
+		}
+		for _, f := range fields {
+			format := f.key
+			if format == "" {
+				format = d.name
+			}
+			if formatMethod(format) == "" {
+				return fmt.Errorf("unknown format %q", format)
+			}
+			if v.formats == nil {
+				v.formats = make(map[string]string)
+			}
+			v.formats[format] = f.value
+		}
+	default:
+		return fmt.Errorf("unknown directive stringer:%s", d.name)
+	}
+	return nil
+}
+
+// field is an argument of a directive, either a value alone or a key=value
+// pair.
+type field struct {
+	key, value string
+	quoted     bool // Whether the value was quoted.
+}
+
+// directiveFields parses the space-separated fields of a directive. Values
+// are either quoted or end at the next space.
+func directiveFields(s string) ([]field, error) {
+	var fields []field
+	for s = strings.TrimSpace(s); s != ""; s = strings.TrimSpace(s) {
+		var f field
+		if i := strings.IndexAny(s, "= \"`"); i > 0 && s[i] == '=' {
+			f.key, s = s[:i], s[i+1:]
+		}
+		if s != "" && (s[0] == '"' || s[0] == '`') {
+			q, err := strconv.QuotedPrefix(s)
+			if err != nil {
+				return nil, fmt.Errorf("unterminated quoted string: %s", s)
+			}
+			f.value, _ = strconv.Unquote(q)
+			f.quoted = true
+			s = s[len(q):]
+			if s != "" && s[0] != ' ' {
+				return nil, fmt.Errorf("expected a space: %s", s)
+			}
+		} else {
+			f.value, s, _ = strings.Cut(s, " ")
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// quotedList parses a comma-separated list of quoted strings.
+func quotedList(s string) ([]string, error) {
+	var list []string
+	for {
+		q, err := strconv.QuotedPrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("expected a quoted string: %s", s)
+		}
+		str, _ := strconv.Unquote(q)
+		list = append(list, str)
+
+		s = strings.TrimSpace(s[len(q):])
+		if s == "" {
+			return list, nil
+		}
+		if s[0] != ',' {
+			return nil, fmt.Errorf("expected a comma: %s", s)
+		}
+		s = strings.TrimSpace(s[1:])
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestQuotedList(t *testing.T) {
+	list, err := quotedList(`"f", "legacy-foo",` + "`raw`")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, list, []string{"f", "legacy-foo", "raw"})
+
+	_, err = quotedList(`foo`)
+	assert.ErrorContains(t, err, "expected a quoted string")
+	_, err = quotedList(`"a" "b"`)
+	assert.ErrorContains(t, err, "expected a comma")
+	_, err = quotedList(`"a",`)
+	assert.ErrorContains(t, err, "expected a quoted string")
+}
+
+func TestDirectiveFields(t *testing.T) {
+	fields, err := directiveFields(`not_found  display="Not Found" text=` + "`nf`")
+	assert.NilError(t, err)
+	assert.Equal(t, len(fields), 3)
+	assert.Equal(t, fields[0], field{"", "not_found", false})
+	assert.Equal(t, fields[1], field{"display", "Not Found", true})
+	assert.Equal(t, fields[2], field{"text", "nf", true})
+
+	_, err = directiveFields(`display="Not Found`)
+	assert.ErrorContains(t, err, "unterminated")
+	_, err = directiveFields(`"a"b`)
+	assert.ErrorContains(t, err, "expected a space")
+}
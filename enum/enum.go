@@ -0,0 +1,141 @@
+// Package enum holds the run time support shared by the code stringer
+// generates.
+package enum
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+var (
+	// ErrUnknownName is matched by the errors parsing a name no value has.
+	ErrUnknownName = errors.New("unknown name")
+
+	// ErrAmbiguousName is matched by the errors parsing an abbreviation
+	// shared by the names of several values.
+	ErrAmbiguousName = errors.New("ambiguous name")
+
+	// ErrUnknownValue is matched by the errors marshaling a value no
+	// constant has.
+	ErrUnknownValue = errors.New("unknown value")
+)
+
+// ValueError is the error returned by generated marshalers given a value
+// that has no name, which could not be unmarshaled again.
+type ValueError struct {
+	Type  string // Name of the type marshaled.
+	Value string // The value in decimal.
+}
+
+func (e *ValueError) Error() string {
+	return e.Type + "(" + e.Value + ") has no name"
+}
+
+func (e *ValueError) Unwrap() error { return ErrUnknownValue }
+
+// ParseError is the error returned by a generated Parse function failing to
+// find the value named by its input.
+type ParseError struct {
+	Type  string   // Name of the type parsed.
+	Input string   // Name failing to parse.
+	Valid []string // Names of the values of the type, which callers may modify.
+
+	// Candidates are the names abbreviated by an ambiguous input.
+	Candidates []string
+
+	// Err is ErrUnknownName or ErrAmbiguousName.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	b.WriteString(strconv.Quote(e.Input))
+	if e.Err == ErrAmbiguousName {
+		b.WriteString(" is ambiguous for " + e.Type + ": ")
+		b.WriteString(strings.Join(e.Candidates, ", "))
+		return b.String()
+	}
+
+	b.WriteString(" is not a valid " + e.Type)
+	if suggestions := e.Suggestions(); len(suggestions) > 0 {
+		b.WriteString(", did you mean ")
+		for i, s := range suggestions {
+			if i > 0 {
+				b.WriteString(" or ")
+			}
+			b.WriteString(strconv.Quote(s))
+		}
+		b.WriteByte('?')
+	}
+	return b.String()
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// Suggestions returns the valid names closest to the input by edit distance,
+// ignoring case. Only names within a third of their length of the input, or
+// a single edit for short names, are suggested.
+func (e *ParseError) Suggestions() []string {
+	var suggestions []string
+	best := -1
+	input := strings.ToLower(e.Input)
+	for _, name := range e.Valid {
+		d := distance(input, strings.ToLower(name))
+		if d > max(1, len([]rune(name))/3) {
+			continue
+		}
+		switch {
+		case best < 0 || d < best:
+			suggestions, best = []string{name}, d
+		case d == best:
+			suggestions = append(suggestions, name)
+		}
+	}
+	return suggestions
+}
+
+// distance returns the Levenshtein distance between a and b, counted in
+// runes.
+func distance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	row := make([]int, len(t)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := range s {
+		prev := row[0]
+		row[0] = i + 1
+		for j := range t {
+			cost := 1
+			if s[i] == t[j] {
+				cost = 0
+			}
+			prev, row[j+1] = row[j+1], min(row[j+1]+1, row[j]+1, prev+cost)
+		}
+	}
+	return row[len(t)]
+}
+
+// deprecated holds the function set by HandleDeprecated.
+var deprecated atomic.Pointer[func(typeName, name string)]
+
+// HandleDeprecated sets the function generated Parse functions call with
+// the name of the type and the input when they return a deprecated value,
+// so that callers can log a warning. A nil function stops the calls.
+func HandleDeprecated(f func(typeName, name string)) {
+	if f == nil {
+		deprecated.Store(nil)
+		return
+	}
+	deprecated.Store(&f)
+}
+
+// Deprecated reports a deprecated value of the named type parsed from name
+// to the function set by HandleDeprecated, if any.
+func Deprecated(typeName, name string) {
+	if f := deprecated.Load(); f != nil {
+		(*f)(typeName, name)
+	}
+}
@@ -0,0 +1,72 @@
+package enum
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseError(t *testing.T) {
+	valid := []string{"Debug", "Info", "Warn", "Warning", "Error"}
+	tt := []struct {
+		input, want string
+	}{
+		{"warnig", `"warnig" is not a valid Level, did you mean "Warning"?`},
+		{"Inf", `"Inf" is not a valid Level, did you mean "Info"?`},
+		{"wart", `"wart" is not a valid Level, did you mean "Warn"?`},
+		{"fatal", `"fatal" is not a valid Level`},
+		{"", `"" is not a valid Level`},
+	}
+	for _, tc := range tt {
+		err := error(&ParseError{Type: "Level", Input: tc.input, Valid: valid, Err: ErrUnknownName})
+		assert.Error(t, err, tc.want)
+		assert.Assert(t, errors.Is(err, ErrUnknownName))
+	}
+}
+
+func TestValueError(t *testing.T) {
+	err := error(&ValueError{Type: "Level", Value: "42"})
+	assert.Error(t, err, "Level(42) has no name")
+	assert.Assert(t, errors.Is(err, ErrUnknownValue))
+}
+
+func TestAmbiguousParseError(t *testing.T) {
+	err := error(&ParseError{
+		Type:       "Level",
+		Input:      "wa",
+		Valid:      []string{"Warn", "Warning"},
+		Candidates: []string{"Warn", "Warning"},
+		Err:        ErrAmbiguousName,
+	})
+	assert.Error(t, err, `"wa" is ambiguous for Level: Warn, Warning`)
+	assert.Assert(t, errors.Is(err, ErrAmbiguousName))
+	assert.Assert(t, !errors.Is(err, ErrUnknownName))
+}
+
+func TestDistance(t *testing.T) {
+	tt := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"warnig", "warning", 1},
+		{"héllo", "hello", 1},
+	}
+	for _, tc := range tt {
+		assert.Equal(t, distance(tc.a, tc.b), tc.want, "%q, %q", tc.a, tc.b)
+	}
+}
+
+func TestHandleDeprecated(t *testing.T) {
+	var got []string
+	HandleDeprecated(func(typeName, name string) {
+		got = append(got, typeName+"="+name)
+	})
+	Deprecated("Level", "warn")
+	HandleDeprecated(nil)
+	Deprecated("Level", "info")
+	assert.DeepEqual(t, got, []string{"Level=warn"})
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: number of values
+//	[3]: lowest value, subtracted from keys to index the array
+//	[4]: name of the String method
+//	[5]: statements setting k to the key named by name
+//	[6]: name of the map type
+//	[7]: name of the constant holding the number of values, or the number
+const (
+	stringEnumCount = `
+// %[7]s is the number of values of %[1]s.
+const %[7]s = %[2]d
+`
+	stringEnumMap = `
+// %[6]s maps each %[1]s value to a V, holding them in an array rather than
+// a Go map. Keys must be values of %[1]s.
+type %[6]s[V any] struct {
+	values [%[7]s]V
+}
+
+// Get returns the element of k, or the zero value of V if k is not a value
+// of %[1]s.
+func (m *%[6]s[V]) Get(k %[1]s) V {
+	if j := uint(k - %[3]s); j < %[7]s {
+		return m.values[j]
+	}
+	var zero V
+	return zero
+}
+
+// Set sets the element of k to v. Keys that are not values of %[1]s are
+// ignored.
+func (m *%[6]s[V]) Set(k %[1]s, v V) {
+	if j := uint(k - %[3]s); j < %[7]s {
+		m.values[j] = v
+	}
+}
+
+// Range calls f for each key and its element in increasing order of keys,
+// until f returns false.
+func (m *%[6]s[V]) Range(f func(k %[1]s, v V) bool) {
+	for j, v := range m.values {
+		if !f(%[1]s(j)+%[3]s, v) {
+			return
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding m as an object keyed by
+// the names of the keys.
+func (m %[6]s[V]) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for j, v := range m.values {
+		if j > 0 {
+			b = append(b, ',')
+		}
+		key, err := json.Marshal((%[1]s(j) + %[3]s).%[4]s())
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		b = append(append(append(b, key...), ':'), value...)
+	}
+	return append(b, '}'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Keys left out keep their
+// elements, and names of no key are an error.
+func (m *%[6]s[V]) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for name, value := range raw {
+%[5]s		if err := json.Unmarshal(value, &m.values[k-%[3]s]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+`
+)
+
+// buildEnumMap generates the map type of an enum whose values are a single
+// run, indexing an array by the distance of keys from the lowest value.
+func (g *Generator) buildEnumMap(typeName string, kind Kind, runs [][]Value) {
+	if kind == Flag || len(runs) > 1 {
+		log.Fatalf("type %s: maps are only supported for enums of consecutive values", typeName)
+	}
+
+	// Lenient parse functions take unknown names for the default value,
+	// which would collect the elements of all of them.
+	key := fmt.Sprintf("\t\tk, err := %s(name)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n", g.parse)
+	if g.lenient {
+		g.use(enumPackage)
+		key = fmt.Sprintf("\t\tk, ok := _%[1]s_lookup(name)\n\t\tif !ok {\n\t\t\treturn &enum.ParseError{Type: %[1]q, Input: name, Valid: append([]string(nil), _%[1]s_names[:]...), Err: enum.ErrUnknownName}\n\t\t}\n", typeName)
+	}
+
+	// The map type falls back to the number itself in place of a
+	// hand-written constant.
+	mapName, countName := g.ident(typeName+"Map"), g.ident(typeName+"Count")
+	count := countName
+	if count == "" {
+		count = strconv.Itoa(len(runs[0]))
+	}
+	args := []any{typeName, len(runs[0]), runs[0][0].originalName, g.callee(typeName, "String"), key, mapName, count}
+	if countName != "" {
+		g.Printf(stringEnumCount, args...)
+	}
+	if mapName != "" {
+		g.use("encoding/json")
+		g.Printf(stringEnumMap, args...)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import "log"
+
+// buildError generates the methods making an enum of error codes implement
+// the error interface. The message is the display name of the code if
+// directives give display names, or its description if generated, falling
+// back to its name.
+func (g *Generator) buildError(typeName string, kind Kind, runs [][]Value, opts typeOptions) {
+	if kind == Flag {
+		log.Fatalf("type %s: errors are only supported for enums", typeName)
+	}
+
+	if name := g.method(typeName, "Error"); name != "" {
+		g.Printf("\n// %s implements the error interface.\n", name)
+		g.Printf("func (i %s) %s() string {\n", typeName, name)
+		switch {
+		case hasFormat(runs, "display"):
+			g.Printf("	return i.%s()\n", g.callee(typeName, "DisplayName"))
+		case opts.description:
+			g.Printf("	if s := i.%s(); s != \"\" {\n", g.callee(typeName, "Description"))
+			g.Printf("		return s\n")
+			g.Printf("	}\n")
+			g.Printf("	return i.%s()\n", g.callee(typeName, "String"))
+		default:
+			g.Printf("	return i.%s()\n", g.callee(typeName, "String"))
+		}
+		g.Printf("}\n")
+	}
+
+	// errors.Is compares codes already, being comparable, so only
+	// finding the code in a chain needs a function.
+	if name := g.ident(typeName + "FromError"); name != "" {
+		g.use("errors")
+		g.Printf("\n// %s returns the first %s in the chain of err.\n", name, typeName)
+		g.Printf("func %s(err error) (%s, bool) {\n", name, typeName)
+		g.Printf("	var i %s\n", typeName)
+		g.Printf("	if errors.As(err, &i) {\n")
+		g.Printf("		return i, true\n")
+		g.Printf("	}\n")
+		g.Printf("	return 0, false\n")
+		g.Printf("}\n")
+	}
+}
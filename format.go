@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// formats are the representations of enum values other than their name,
+// given by directives, along with the methods returning them.
+var formats = []struct {
+	name, method, doc string
+}{
+	{"json", "JSONName", "the name of i in JSON"},
+	{"text", "TextName", "the name of i in text encodings"},
+	{"display", "DisplayName", "the name of i to display to people"},
+}
+
+// formatMethod returns the name of the method returning the format, or ""
+// if there is no such format.
+func formatMethod(format string) string {
+	for _, f := range formats {
+		if f.name == format {
+			return f.method
+		}
+	}
+	return ""
+}
+
+// formatName returns the name of the value in the format, which is its name
+// unless a directive gives another one.
+func (v *Value) formatName(format string) string {
+	if name, ok := v.formats[format]; ok {
+		return name
+	}
+	return v.name
+}
+
+// hasFormat reports whether a directive gives the name of any of the values
+// in the format.
+func hasFormat(runs [][]Value, format string) bool {
+	for _, values := range runs {
+		for _, v := range values {
+			if _, ok := v.formats[format]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+const stringOrdinalSearch = `
+// _%[1]s_ordinal returns the position of i among the values of %[1]s, or -1.
+func _%[1]s_ordinal(i %[1]s) int {
+	lo, hi := 0, len(_%[1]s_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _%[1]s_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_%[1]s_values) && _%[1]s_values[lo] == i {
+		return lo
+	}
+	return -1
+}
+`
+
+// declareOrdinal declares the function returning the position of a value
+// among the values of runs, indexing the tables declared per value. Flags and
+// sparse values are searched as in LayoutSearch, the others found by
+// switching over the runs.
+func (g *Generator) declareOrdinal(typeName string, kind Kind, runs [][]Value, layout Layout) {
+	if g.emitted["ordinal"] {
+		return
+	}
+	g.emitted["ordinal"] = true
+
+	if kind == Flag || layout == LayoutSearch || layout == LayoutMap {
+		g.declareValues(typeName, runs)
+		g.Printf(stringOrdinalSearch, typeName)
+		return
+	}
+
+	g.Printf("\n// _%[1]s_ordinal returns the position of i among the values of %[1]s, or -1.\n", typeName)
+	g.Printf("func _%[1]s_ordinal(i %[1]s) int {\n", typeName)
+	g.Printf("	switch {\n")
+	n := 0
+	for _, values := range runs {
+		first, last := &values[0], &values[len(values)-1]
+		var cond string
+		switch {
+		case len(values) == 1:
+			cond = fmt.Sprintf("i == %s", first)
+		case first.value == 0 && !first.signed:
+			cond = fmt.Sprintf("i <= %s", last)
+		default:
+			cond = fmt.Sprintf("i >= %s && i <= %s", first, last)
+		}
+		ordinal := "int(i)"
+		if first.value != 0 {
+			ordinal = fmt.Sprintf("int(i - %s)", first)
+		}
+		switch {
+		case len(values) == 1:
+			ordinal = strconv.Itoa(n)
+		case n > 0:
+			ordinal += fmt.Sprintf(" + %d", n)
+		}
+		g.Printf("	case %s:\n", cond)
+		g.Printf("		return %s\n", ordinal)
+		n += len(values)
+	}
+	g.Printf("	}\n")
+	g.Printf("	return -1\n")
+	g.Printf("}\n")
+}
+
+// declareValueTable declares the concatenated texts of the values of runs
+// and the index slice into them, in the order of the values, under the names
+// _T_table and _T_index.
+func (g *Generator) declareValueTable(typeName, table, index string, runs [][]Value, text func(v *Value) string) {
+	b := new(bytes.Buffer)
+	var indexes []int
+	for _, values := range runs {
+		for i := range values {
+			b.WriteString(text(&values[i]))
+			indexes = append(indexes, b.Len())
+		}
+	}
+
+	g.Printf("\nconst _%s_%s = %q\n\n", typeName, table, b.String())
+	g.Printf("var _%s_%s = [...]uint%d{0", typeName, index, usize(b.Len()))
+	for _, index := range indexes {
+		g.Printf(", %d", index)
+	}
+	g.Printf("}\n")
+}
+
+// buildFormats generates the accessors returning the names of enum values
+// in the formats directives give names in, and the text and JSON marshalers
+// encoding values by those names.
+func (g *Generator) buildFormats(typeName string, kind Kind, layout Layout, runs [][]Value, opts typeOptions) {
+	for _, f := range formats {
+		if !hasFormat(runs, f.name) {
+			continue
+		}
+		if kind == Flag {
+			log.Fatalf("type %s: names in other formats are only supported for enums", typeName)
+		}
+		g.declareOrdinal(typeName, kind, runs, layout)
+		g.declareValueTable(typeName, f.name+"_name", f.name+"_index", runs, func(v *Value) string {
+			return v.formatName(f.name)
+		})
+		if name := g.method(typeName, f.method); name != "" {
+			g.Printf("\n// %s returns %s.\n", name, f.doc)
+			g.Printf("func (i %s) %s() string {\n", typeName, name)
+			g.Printf("	if j := _%s_ordinal(i); j >= 0 {\n", typeName)
+			g.Printf("		return _%[1]s_%[2]s_name[_%[1]s_%[2]s_index[j]:_%[1]s_%[2]s_index[j+1]]\n", typeName, f.name)
+			g.Printf("	}\n")
+			g.Printf("	return i.%s()\n", g.callee(typeName, "String"))
+			g.Printf("}\n")
+		}
+	}
+
+	if opts.textMarshal {
+		g.buildMarshalers(typeName, kind, layout, runs, "text", "MarshalText", "UnmarshalText", "encoding.Text")
+	}
+	if opts.jsonMarshal {
+		g.buildMarshalers(typeName, kind, layout, runs, "json", "MarshalJSON", "UnmarshalJSON", "json.")
+	}
+}
+
+// buildMarshalers generates the methods encoding and decoding values by
+// their names in the format, falling back to String and Parse if no value
+// is given another name in it. The methods implement the Marshaler and
+// Unmarshaler interfaces named with the prefix iface. Values without a
+// name, which could not be decoded, fail to encode.
+func (g *Generator) buildMarshalers(typeName string, kind Kind, layout Layout, runs [][]Value, format, marshal, unmarshal, iface string) {
+	nameOf := fmt.Sprintf("i.%s()", g.callee(typeName, "String"))
+	parse := g.parse
+	if hasFormat(runs, format) {
+		nameOf = fmt.Sprintf("i.%s()", g.callee(typeName, formatMethod(format)))
+		parse = fmt.Sprintf("_%s_%s_parse", typeName, format)
+		g.buildFormatParse(typeName, runs, format)
+	}
+
+	if name := g.method(typeName, marshal); name != "" {
+		unknown := fmt.Sprintf("_%s_ordinal(i) < 0", typeName)
+		if kind == Flag {
+			g.declareFlagMask(typeName, runs)
+			unknown = fmt.Sprintf("i&^_%s_mask != 0", typeName)
+		} else {
+			g.declareOrdinal(typeName, kind, runs, layout)
+		}
+		number := "strconv.FormatUint(uint64(i), 10)"
+		if runs[0][0].signed {
+			number = "strconv.FormatInt(int64(i), 10)"
+		}
+		g.use("strconv")
+		g.use(enumPackage)
+
+		g.Printf("\n// %s implements %sMarshaler.\n", name, iface)
+		g.Printf("func (i %s) %s() ([]byte, error) {\n", typeName, name)
+		g.Printf("	if %s {\n", unknown)
+		g.Printf("		return nil, &enum.ValueError{Type: %q, Value: %s}\n", typeName, number)
+		g.Printf("	}\n")
+		if format == "json" {
+			g.use("encoding/json")
+			g.Printf("	return json.Marshal(%s)\n", nameOf)
+		} else {
+			g.Printf("	return []byte(%s), nil\n", nameOf)
+		}
+		g.Printf("}\n")
+	}
+
+	if name := g.method(typeName, unmarshal); name != "" {
+		g.Printf("\n// %s implements %sUnmarshaler.\n", name, iface)
+		g.Printf("func (i *%s) %s(b []byte) error {\n", typeName, name)
+		arg := "string(b)"
+		if format == "json" {
+			g.Printf("	var s string\n")
+			g.Printf("	if err := json.Unmarshal(b, &s); err != nil {\n")
+			g.Printf("		return err\n")
+			g.Printf("	}\n")
+			arg = "s"
+		}
+		if g.lenient && hasFormat(runs, format) {
+			g.Printf("	*i = %s(%s)\n", parse, arg)
+			g.Printf("	return nil\n")
+			g.Printf("}\n")
+			return
+		}
+		g.Printf("	v, err := %s(%s)\n", parse, arg)
+		g.Printf("	if err != nil {\n")
+		g.Printf("		return err\n")
+		g.Printf("	}\n")
+		g.Printf("	*i = v\n")
+		g.Printf("	return nil\n")
+		g.Printf("}\n")
+	}
+}
+
+// buildFormatParse generates the function returning the enum value named
+// by a string in the format, or by one of its aliases.
+func (g *Generator) buildFormatParse(typeName string, runs [][]Value, format string) {
+	var names []parseName
+	n := 0
+	for _, values := range runs {
+		for j := range values {
+			name := values[j].formatName(format)
+			expr := fmt.Sprintf("_%s_%s_name[%d:%d]", typeName, format, n, n+len(name))
+			names = append(names, parseName{value: &values[j], name: name, expr: expr})
+			n += len(name)
+		}
+	}
+	for _, values := range runs {
+		for j := range values {
+			for _, alias := range values[j].aliases {
+				names = append(names, parseName{value: &values[j], name: alias, expr: strconv.Quote(alias), alias: true})
+			}
+		}
+	}
+	g.checkParseNames(typeName, names, typeOptions{})
+
+	if g.lenient {
+		g.buildLenientFormatParse(typeName, runs, names, format)
+		return
+	}
+
+	g.Printf("\nvar _%s_%s_names = [...]string{\n", typeName, format)
+	for _, n := range names {
+		if !n.alias {
+			g.Printf("\t%s,\n", n.expr)
+		}
+	}
+	g.Printf("}\n")
+
+	// As for Parse, values parsed from deprecated names are reported by
+	// a wrapper.
+	fn := fmt.Sprintf("_%s_%s_parse", typeName, format)
+	if hasDeprecated(runs) {
+		inner := fmt.Sprintf("_%s_%s_match", typeName, format)
+		defer g.buildParseDeprecated(typeName, Enum, runs, fn, inner, "")
+		fn = inner
+	}
+
+	g.Printf("\nfunc %s(s string) (%s, error) {\n", fn, typeName)
+	g.lookupSwitch(names, "nil")
+	g.Printf("	%s\n", g.unknownParse(typeName, fmt.Sprintf("_%s_%s_names", typeName, format)))
+	g.Printf("}\n")
+}
+
+// buildLenientFormatParse generates the function returning the enum value
+// named by a string in the format, or the default value for strings naming
+// none, which cannot fail. Deprecated values are reported as by Parse.
+func (g *Generator) buildLenientFormatParse(typeName string, runs [][]Value, names []parseName, format string) {
+	g.Printf("\nfunc _%[1]s_%[2]s_lookup(s string) (%[1]s, bool) {\n", typeName, format)
+	g.lookupSwitch(names, "true")
+	g.Printf("	return 0, false\n")
+	g.Printf("}\n")
+
+	if hasDeprecated(runs) {
+		g.declareDeprecated(typeName, Enum, runs)
+		g.use(enumPackage)
+	}
+	g.Printf("\nfunc _%[1]s_%[2]s_parse(s string) %[1]s {\n", typeName, format)
+	g.Printf("	if i, ok := _%s_%s_lookup(s); ok {\n", typeName, format)
+	if hasDeprecated(runs) {
+		g.Printf("		if _%s_deprecated(i) {\n", typeName)
+		g.Printf("			enum.Deprecated(%q, s)\n", typeName)
+		g.Printf("		}\n")
+	}
+	g.Printf("		return i\n")
+	g.Printf("	}\n")
+	g.Printf("	return %s\n", g.fallback.originalName)
+	g.Printf("}\n")
+}
@@ -2,15 +2,26 @@ package main
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/constant"
 	"go/format"
+	"go/importer"
+	"go/parser"
 	"go/token"
 	"go/types"
+	"io"
 	"log"
+	"math/bits"
+	"os"
+	"os/exec"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -22,6 +33,39 @@ const (
 	Enum
 )
 
+// Layout selects how the name of a value is looked up.
+type Layout int
+
+const (
+	LayoutDefault Layout = iota // Follow the -layout flag, picking a layout as LayoutAuto unless it says otherwise.
+	LayoutAuto                  // Pick one of the layouts below depending on the values.
+	LayoutSwitch                // Index into a run of names, switching over runs.
+	LayoutSearch                // Binary search a sorted array of values.
+	LayoutMap                   // Look up a map from values to names.
+)
+
+// maxSwitchRuns is the largest number of runs LayoutAuto switches over.
+const maxSwitchRuns = 8
+
+// Optimize selects whether layout decisions favor speed or code size.
+type Optimize int
+
+const (
+	OptimizeDefault Optimize = iota // Follow the -optimize flag, favoring speed unless it says otherwise.
+	OptimizeSpeed                   // Unroll lookups into switches and if chains.
+	OptimizeSize                    // Drive lookups by tables.
+)
+
+// ConflictPolicy decides what happens to a generated method whose name is
+// already taken by a hand-written method of the type.
+type ConflictPolicy int
+
+const (
+	ConflictError  ConflictPolicy = iota // Report the conflict.
+	ConflictSkip                         // Leave the generated method out.
+	ConflictRename                       // Generate it as an unexported method.
+)
+
 // isPow2 returns true of v is a power-of-two value.
 func isPow2(x uint64) bool {
 	return (x & (x - 1)) == 0
@@ -51,9 +95,10 @@ type File struct {
 	kind     Kind   // Type of the constant type, either enum or flag.
 	typeName string // Name of the constant type we're currently looking for.
 
-	values      []Value // Accumulator for constant values of that type.
-	trimPrefix  string  // prefix to be trimmed from value names.
-	lineComment bool    // use line comment as flag name.
+	values      []Value   // Accumulator for constant values of that type.
+	trimPrefix  string    // prefix to be trimmed from value names.
+	transform   Transform // transformation of the trimmed value names.
+	lineComment bool      // use line comment as flag name.
 }
 
 type Package struct {
@@ -62,6 +107,9 @@ type Package struct {
 	defs  map[*ast.Ident]types.Object
 	files []*File
 	dir   string
+
+	types *types.Package // Type-checked package, used to check the generated code.
+	sizes types.Sizes
 }
 
 // Generator holds the state of the analysis. Primarily used to buffer
@@ -69,12 +117,34 @@ type Package struct {
 type Generator struct {
 	buf  bytes.Buffer // Accumulated output.
 	pkgs []*Package
+
+	outputName string          // Absolute path of the output file, if any.
+	imports    map[string]bool // Packages used by the generated code.
+	catalogs   catalogs        // Translations of constant names, by language.
+	graphs     []*graph        // State machines given by transitions, by type.
+
+	// These fields are reset for each type being generated.
+	declared map[string]bool // Names of the hand-written methods of the type.
+	conflict ConflictPolicy  // What to do about generated methods in declared.
+	emitted  map[string]bool // Helper declarations already generated for the type.
+	fallback *Value          // Value marked by a stringer:default directive, if any.
+	lenient  bool            // Parse unknown names as the fallback value.
+	unknown  bool            // Name unknown values after the fallback value.
+	parse    string          // Name of the Parse function, once generated.
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
 	fmt.Fprintf(&g.buf, format, args...)
 }
 
+// use records that the generated code refers to the package at path.
+func (g *Generator) use(path string) {
+	if g.imports == nil {
+		g.imports = make(map[string]bool)
+	}
+	g.imports[path] = true
+}
+
 // parsePackage analyzes the single package constructed from the patterns and tags.
 // parsePackage exits if there is an error.
 func (g *Generator) parsePackage(patterns []string, tags []string) error {
@@ -106,6 +176,8 @@ func (g *Generator) parsePackage(patterns []string, tags []string) error {
 			fset:  pkg.Fset,
 			defs:  pkg.TypesInfo.Defs,
 			files: make([]*File, len(pkg.Syntax)),
+			types: pkg.Types,
+			sizes: pkg.TypesSizes,
 		}
 
 		for j, file := range pkg.Syntax {
@@ -122,24 +194,9 @@ func (g *Generator) parsePackage(patterns []string, tags []string) error {
 	return nil
 }
 
-// generateStart produces the start of a Go source code file.
-func (g *Generator) generateStart(usesFlags bool) {
-	g.Printf("package %s", g.pkgs[0].name)
-	g.Printf("\n")
-	g.Printf("import (\n")
-
-	if usesFlags {
-		g.Printf("	\"math/bits\"\n")
-	}
-	g.Printf("	\"strconv\"\n")
-	if usesFlags {
-		g.Printf("	\"strings\"\n")
-	}
-	g.Printf(")\n")
-}
-
-// generate produces the String method for the named type.
-func (g *Generator) generate(typeName string, kind Kind, trimPrefix string, lineComment bool, getterSetter bool) {
+// collect returns the values of the type the options are for, in the order
+// they are declared.
+func (g *Generator) collect(opts typeOptions) []Value {
 	values := make([]Value, 0, 100)
 
 	for _, pkg := range g.pkgs {
@@ -147,10 +204,11 @@ func (g *Generator) generate(typeName string, kind Kind, trimPrefix string, line
 			// Set the state for this run of the walker.
 			file.values = nil
 
-			file.kind = kind
-			file.typeName = typeName
-			file.trimPrefix = trimPrefix
-			file.lineComment = lineComment
+			file.kind = opts.kind
+			file.typeName = opts.name
+			file.trimPrefix = opts.trimPrefix
+			file.transform = opts.transform
+			file.lineComment = opts.lineComment
 			if file.file != nil {
 				ast.Inspect(file.file, file.genDecl)
 				values = append(values, file.values...)
@@ -159,8 +217,22 @@ func (g *Generator) generate(typeName string, kind Kind, trimPrefix string, line
 	}
 
 	if len(values) == 0 {
-		log.Fatalf("no values defined for type %s", typeName)
+		log.Fatalf("no values defined for type %s", opts.name)
 	}
+	return values
+}
+
+// generate produces the String method for the named type.
+func (g *Generator) generate(opts typeOptions) {
+	typeName, kind := opts.name, opts.kind
+	values := g.collect(opts)
+
+	g.declared = g.declaredMethods(typeName)
+	g.conflict = opts.conflict
+	g.emitted = make(map[string]bool)
+	g.fallback = findDefault(typeName, kind, values, opts)
+	g.lenient, g.unknown = opts.lenient, opts.stringDefault
+	g.parse = ""
 
 	// Generate code that will fail if the constants change value.
 	g.Printf("\nfunc _() {\n")
@@ -171,26 +243,218 @@ func (g *Generator) generate(typeName string, kind Kind, trimPrefix string, line
 		g.Printf("\t_ = x[%s - %s]\n", v.originalName, v.str)
 	}
 	g.Printf("}\n")
+	// Constants sharing a value are left out of the runs, but their
+	// transitions still count.
+	constants := slices.Clone(values)
 	runs := splitIntoRuns(values, kind)
 
+	precompute := kind == Flag && countFlags(runs) <= opts.precompute
+
+	layout := opts.layout
+	if layout == LayoutDefault || layout == LayoutAuto {
+		// When optimizing for size, the if chains testing each flag are
+		// replaced by a loop. Switching over a few runs of enum values is
+		// still smaller than searching them.
+		maxRuns := maxSwitchRuns
+		switch {
+		case opts.optimize != OptimizeSize:
+		case kind == Enum:
+			maxRuns = 2
+		default:
+			maxRuns = 0
+		}
+
+		layout = LayoutSwitch
+		if len(runs) > maxRuns {
+			layout = LayoutSearch
+		}
+	}
+	if opts.optimize == OptimizeSize {
+		precompute = false
+	}
+
 	switch {
-	case len(runs) == 1 && kind == Enum:
+	case layout == LayoutSearch:
+		g.buildSearch(typeName, kind, runs, precompute)
+	case layout == LayoutMap:
+		g.buildMap(typeName, kind, runs, precompute)
+	case kind == Flag:
+		g.buildFlagsMultipleRuns(typeName, runs, precompute)
+	case len(runs) == 1:
 		g.buildOneRun(runs, typeName)
-	case len(runs) <= 8:
-		if kind == Flag {
-			g.buildFlagsMultipleRuns(typeName, runs)
-			g.buildFlagStringMethod(typeName)
-		} else {
-			g.buildMultipleRuns(runs, typeName)
+	default:
+		g.buildMultipleRuns(runs, typeName)
+	}
+
+	if kind == Flag && opts.getterSetter {
+		g.buildFlagGetterSetters(typeName, values, opts)
+	}
+
+	if kind == Flag && opts.setOps {
+		g.buildFlagSetOps(typeName, runs)
+	}
+
+	// The marshalers parse names unless they are given in another format,
+	// and so do the ones of sets and maps.
+	textParse := opts.textMarshal && !hasFormat(runs, "text")
+	jsonParse := opts.jsonMarshal && !hasFormat(runs, "json")
+	if opts.parseFunc || opts.parseFold || opts.parsePrefix || textParse || jsonParse || opts.set || opts.enumMap {
+		g.buildParse(typeName, kind, layout, runs, opts)
+	}
+
+	g.buildFormats(typeName, kind, layout, runs, opts)
+
+	if opts.description {
+		g.buildDescription(typeName, kind, layout, runs)
+	}
+
+	g.buildMeta(typeName, kind, layout, runs)
+
+	if opts.logValue {
+		g.buildLogValue(typeName, kind, runs)
+	}
+
+	if opts.formatter {
+		g.buildFormat(typeName, kind, runs, opts)
+	}
+
+	if opts.errorCode {
+		g.buildError(typeName, kind, runs, opts)
+	}
+
+	if opts.deprecated {
+		g.buildDeprecated(typeName, kind, runs)
+	}
+
+	if opts.values {
+		g.buildValues(typeName, runs, opts.allValues)
+	}
+
+	if opts.set {
+		g.buildSet(typeName, kind, layout, runs)
+	}
+
+	if opts.enumMap {
+		g.buildEnumMap(typeName, kind, runs)
+	}
+
+	g.buildTransitions(typeName, kind, opts.trimPrefix, constants, runs)
+
+	if opts.navigation {
+		g.buildNavigation(typeName, kind, layout, runs)
+	}
+
+	g.buildLocalized(typeName, kind, layout, runs)
+}
+
+// declaredMethods returns the names of the methods declared for the named
+// type, leaving out the ones declared by a previous version of the output file.
+func (g *Generator) declaredMethods(typeName string) map[string]bool {
+	declared := make(map[string]bool)
+	for _, pkg := range g.pkgs {
+		obj, ok := pkg.types.Scope().Lookup(typeName).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok {
+			continue
+		}
+		for i := 0; i < named.NumMethods(); i++ {
+			m := named.Method(i)
+			if pkg.fset.Position(m.Pos()).Filename == g.outputName {
+				continue
+			}
+			declared[m.Name()] = true
+		}
+	}
+	return declared
+}
+
+// method returns the name to declare the generated method name of the named
+// type with. It is empty if the method is to be left out because a
+// hand-written method already uses that name.
+func (g *Generator) method(typeName, name string) string {
+	if !g.declared[name] {
+		return name
+	}
+
+	switch g.conflict {
+	case ConflictSkip:
+		return ""
+	case ConflictRename:
+		// A hand-written String method can build on the generated
+		// tables by calling tName, t being the lower-cased type name.
+		renamed := name
+		if name == "String" {
+			renamed = "Name"
 		}
+		r, n := utf8.DecodeRuneInString(typeName)
+		renamed = string(unicode.ToLower(r)) + typeName[n:] + renamed
+		if g.declared[renamed] {
+			log.Fatalf("type %s already declares methods %s and %s", typeName, name, renamed)
+		}
+		return renamed
 	default:
-		g.buildMap(typeName, kind, runs)
-		g.buildFlagStringMethod(typeName)
+		log.Fatalf("type %s already declares method %s", typeName, name)
+		return ""
 	}
+}
 
-	if kind == Flag && getterSetter {
-		g.buildFlagGetterSetters(typeName, values)
+// declaredIdent reports whether the package declares name at package level
+// outside the output file.
+func (g *Generator) declaredIdent(name string) bool {
+	for _, pkg := range g.pkgs {
+		obj := pkg.types.Scope().Lookup(name)
+		if obj != nil && pkg.fset.Position(obj.Pos()).Filename != g.outputName {
+			return true
+		}
 	}
+	return false
+}
+
+// ident returns the name to declare the generated package-level identifier
+// name with, following the conflict policy of the type as method does. A
+// renamed identifier is unexported.
+func (g *Generator) ident(name string) string {
+	if !g.declaredIdent(name) {
+		return name
+	}
+
+	switch g.conflict {
+	case ConflictSkip:
+		return ""
+	case ConflictRename:
+		r, n := utf8.DecodeRuneInString(name)
+		renamed := string(unicode.ToLower(r)) + name[n:]
+		if g.declaredIdent(renamed) {
+			log.Fatalf("package already declares %s and %s", name, renamed)
+		}
+		return renamed
+	default:
+		log.Fatalf("package already declares %s", name)
+		return ""
+	}
+}
+
+// callee returns the name to call the method name of the named type by. This
+// is the hand-written method if the generated one is left out.
+func (g *Generator) callee(typeName, name string) string {
+	if generated := g.method(typeName, name); generated != "" {
+		return generated
+	}
+	return name
+}
+
+// codeSize returns the size of the gofmt-ed code generated since the buffer
+// held start bytes.
+func (g *Generator) codeSize(start int) int {
+	const header = "package p\n"
+	src, err := format.Source(append([]byte(header), g.buf.Bytes()[start:]...))
+	if err != nil {
+		return g.buf.Len() - start
+	}
+	return len(src) - len(header)
 }
 
 // splitIntoRuns breaks the values into runs of contiguous sequences.
@@ -203,13 +467,21 @@ func splitIntoRuns(values []Value, kind Kind) [][]Value {
 	// so use that one. The String method won't care about which named constant
 	// was the argument, so the first name for the given value is the only one to keep.
 	// We need to do this because identical values would cause the switch or map
-	// to fail to compile.
+	// to fail to compile. Parse still accepts the names of the constants
+	// left out, as aliases of the one kept.
 	j := 1
 	for i := 1; i < len(values); i++ {
-		if values[i].value != values[i-1].value {
-			values[j] = values[i]
-			j++
+		if kept := &values[j-1]; values[i].value == kept.value {
+			kept.aliases = slices.Clip(kept.aliases)
+			for _, name := range append([]string{values[i].name}, values[i].aliases...) {
+				if name != kept.name && !slices.Contains(kept.aliases, name) {
+					kept.aliases = append(kept.aliases, name)
+				}
+			}
+			continue
 		}
+		values[j] = values[i]
+		j++
 	}
 	values = values[:j]
 	runs := make([][]Value, 0, 10)
@@ -231,23 +503,119 @@ func splitIntoRuns(values []Value, kind Kind) [][]Value {
 	return runs
 }
 
-// format returns the gofmt-ed contents of the Generator's buffer.
-func (g *Generator) format() []byte {
-	src, err := format.Source(g.buf.Bytes())
+// format returns the gofmt-ed contents of the Generator's buffer, preceded
+// by the package clause and the imports the generated code uses.
+func (g *Generator) format() ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s\n", g.pkgs[0].name)
+	if len(g.imports) > 0 {
+		paths := make([]string, 0, len(g.imports))
+		for path := range g.imports {
+			paths = append(paths, path)
+		}
+		// Standard library packages go first, in a group of their own.
+		isStd := func(path string) bool {
+			first, _, _ := strings.Cut(path, "/")
+			return !strings.Contains(first, ".")
+		}
+		sort.Slice(paths, func(i, j int) bool {
+			if isStd(paths[i]) != isStd(paths[j]) {
+				return isStd(paths[i])
+			}
+			return paths[i] < paths[j]
+		})
+
+		buf.WriteString("import (\n")
+		for i, path := range paths {
+			if i > 0 && isStd(paths[i-1]) != isStd(path) {
+				buf.WriteString("\n")
+			}
+			fmt.Fprintf(&buf, "\t%q\n", path)
+		}
+		buf.WriteString(")\n")
+	}
+	buf.Write(g.buf.Bytes())
+
+	src, err := format.Source(buf.Bytes())
 	if err != nil {
 		// Should never happen, but can arise when developing this code.
-		// The user can compile the output to see the error.
-		log.Printf("warning: internal error: invalid Go generated: %s", err)
-		log.Printf("warning: compile the package to analyze the error")
-		return g.buf.Bytes()
+		return nil, fmt.Errorf("internal error: invalid Go generated: %w", err)
 	}
-	return src
+	return src, nil
+}
+
+// check type-checks the generated source together with the files of the
+// package it belongs to. Conflicts with hand-written declarations, such as
+// an existing String method, are reported as errors. A file named
+// outputName is left out, as it is about to be replaced by src.
+func (g *Generator) check(src []byte, outputName string) error {
+	pkg := g.pkgs[0]
+
+	generated, err := parser.ParseFile(pkg.fset, outputName, src, parser.SkipObjectResolution)
+	if err != nil {
+		return fmt.Errorf("internal error: invalid Go generated: %w", err)
+	}
+
+	files := []*ast.File{generated}
+	for _, file := range pkg.files {
+		if pkg.fset.File(file.file.FileStart).Name() == outputName {
+			continue
+		}
+		files = append(files, file.file)
+	}
+
+	imports := make(map[string]*types.Package)
+	for _, imp := range pkg.types.Imports() {
+		imports[imp.Path()] = imp
+	}
+
+	var errs []error
+	conf := types.Config{
+		Importer: packageImporter{imports: imports, fallback: importer.ForCompiler(pkg.fset, "gc", lookupExport)},
+		Sizes:    pkg.sizes,
+		Error: func(err error) {
+			errs = append(errs, err)
+		},
+	}
+	_, _ = conf.Check(pkg.types.Path(), pkg.fset, files, nil)
+
+	return errors.Join(errs...)
+}
+
+// packageImporter resolves imports from the packages already loaded for the
+// analyzed package, falling back to compiler export data for the rest.
+type packageImporter struct {
+	imports  map[string]*types.Package
+	fallback types.Importer
+}
+
+func (p packageImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := p.imports[path]; ok {
+		return pkg, nil
+	}
+	return p.fallback.Import(path)
+}
+
+// lookupExport opens the export data of the package at path, such as the
+// run time support of the generated code, which the analyzed package need
+// not import yet. The go command builds it if need be.
+func lookupExport(path string) (io.ReadCloser, error) {
+	out, err := exec.Command("go", "list", "-export", "-f", "{{.Export}}", path).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			err = fmt.Errorf("%w: %s", err, bytes.TrimSpace(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("go list %s: %w", path, err)
+	}
+	return os.Open(strings.TrimSpace(string(out)))
 }
 
 // Value represents a declared constant.
 type Value struct {
 	originalName string // The name of the constant.
-	name         string // The name with trimmed prefix.
+	accessor     string // The name flag accessors are named after: the line comment, or the trimmed name.
+	name         string // The name String returns, the transformed trimmed name by default.
 	// The value is stored as a bit pattern alone. The boolean tells us
 	// whether to interpret it as an int64 or a uint64; the only place
 	// this matters is when sorting.
@@ -256,6 +624,15 @@ type Value struct {
 	value  uint64 // Will be converted to int64 when needed.
 	signed bool   // Whether the constant is a signed type.
 	str    string // The string representation given by the "go/constant" package.
+
+	aliases []string          // Other names Parse accepts for the value.
+	formats map[string]string // Names in other formats, such as json, by format.
+
+	description string               // Text of the doc or line comment of the constant.
+	meta        map[string]metaValue // Metadata given by stringer:meta directives, by key.
+	deprecated  bool                 // Whether the doc comment has a Deprecated: paragraph.
+	isDefault   bool                 // Whether a stringer:default directive marks the value.
+	transitions []string             // States given by stringer:transitions directives.
 }
 
 func (v *Value) String() string {
@@ -324,6 +701,14 @@ func (f *File) genDecl(node ast.Node) bool {
 			// This is not the type we're looking for.
 			continue
 		}
+		// Directives about the constants come in their doc or line comment,
+		// or in the doc comment of an unparenthesized declaration.
+		doc := vspec.Doc
+		if !decl.Lparen.IsValid() {
+			doc = decl.Doc
+		}
+		dirs := directives(doc, vspec.Comment)
+
 		// We now have a list of names (from one line of source code) all being
 		// declared with the desired type.
 		// Grab their names and actual values and store them in f.values.
@@ -363,10 +748,24 @@ func (f *File) genDecl(node ast.Node) bool {
 				signed:       info&types.IsUnsigned == 0,
 				str:          value.String(),
 			}
-			if c := vspec.Comment; f.lineComment && c != nil && len(c.List) == 1 {
+			if c := vspec.Comment; f.lineComment && c != nil && len(c.List) == 1 && !isDirective(c.List[0]) {
 				v.name = strings.TrimSpace(c.Text())
+				v.accessor = v.name
 			} else {
-				v.name = strings.TrimPrefix(v.originalName, f.trimPrefix)
+				v.accessor = strings.TrimPrefix(v.originalName, f.trimPrefix)
+				v.name = f.transform.apply(v.accessor)
+				if c != nil {
+					v.description = docText(c)
+				}
+			}
+			if doc != nil && len(docText(doc)) > 0 {
+				v.description = docText(doc)
+			}
+			v.deprecated = doc != nil && isDeprecated(doc) || vspec.Comment != nil && isDeprecated(vspec.Comment)
+			for _, d := range dirs {
+				if err := d.apply(&v); err != nil {
+					log.Fatalf("%s: %s", f.pkg.fset.Position(d.pos), err)
+				}
 			}
 			f.values = append(f.values, v)
 		}
@@ -445,9 +844,11 @@ func (g *Generator) declareNameVars(runs [][]Value, typeName string, suffix stri
 //	[1]: type name
 //	[2]: size of index element (8 for uint8 etc.)
 //	[3]: less than zero check (for signed types)
-const stringOneRun = `func (i %[1]s) String() string {
+//	[4]: method name
+//	[5]: name of unknown values
+const stringOneRun = `func (i %[1]s) %[4]s() string {
 	if %[3]si >= %[1]s(len(_%[1]s_index)-1) {
-		return "%[1]s(" + strconv.FormatInt(int64(i), 10) + ")"
+		return %[5]s
 	}
 	return _%[1]s_name[_%[1]s_index[i]:_%[1]s_index[i+1]]
 }
@@ -459,10 +860,12 @@ const stringOneRun = `func (i %[1]s) String() string {
 //	[2]: lowest defined value for type, as a string
 //	[3]: size of index element (8 for uint8 etc.)
 //	[4]: less than zero check (for signed types)
-const stringOneRunWithOffset = `func (i %[1]s) String() string {
+//	[5]: method name
+//	[6]: name of unknown values
+const stringOneRunWithOffset = `func (i %[1]s) %[5]s() string {
 	i -= %[2]s
 	if %[4]si >= %[1]s(len(_%[1]s_index)-1) {
-		return "%[1]s(" + strconv.FormatInt(int64(i + %[2]s), 10) + ")"
+		return %[6]s
 	}
 	return _%[1]s_name[_%[1]s_index[i] : _%[1]s_index[i+1]]
 }
@@ -479,89 +882,137 @@ func (g *Generator) buildOneRun(runs [][]Value, typeName string) {
 		lessThanZero = "i < 0 || "
 	}
 
-	if values[0].value == 0 { // Signed or unsigned, 0 is still 0.
-		g.Printf(stringOneRun, typeName, usize(len(values)), lessThanZero)
-	} else {
-		g.Printf(stringOneRunWithOffset, typeName, values[0].String(), usize(len(values)), lessThanZero)
+	name := g.method(typeName, "String")
+	switch {
+	case name == "":
+	case values[0].value == 0: // Signed or unsigned, 0 is still 0.
+		g.Printf(stringOneRun, typeName, usize(len(values)), lessThanZero, name, g.unknownName(typeName, "i"))
+	default:
+		g.Printf(stringOneRunWithOffset, typeName, values[0].String(), usize(len(values)), lessThanZero, name,
+			g.unknownName(typeName, "i + "+values[0].String()))
 	}
 }
 
-// buildFlagsMultipleRuns generates the variables and String method for multiple runs of contiguous values.
-// For this pattern, a single Printf format won't do.
-func (g *Generator) buildFlagsMultipleRuns(typeName string, runs [][]Value) {
+// buildFlagsMultipleRuns generates the variables and methods for multiple runs of flags.
+// With precompute, String looks up the names of all combinations of flags in a table.
+func (g *Generator) buildFlagsMultipleRuns(typeName string, runs [][]Value, precompute bool) {
 	g.Printf("\n")
 	g.declareIndexAndNameVars(runs, typeName)
 
-	g.buildFlagActiveFlagsMethodStart(typeName, runs)
-
+	var zero string
+	var flags []flagName
 	for i, values := range runs {
-		if len(values) == 1 {
-			if values[0].value == 0 {
-				continue
+		for j := range values {
+			var expr string
+			if len(values) == 1 {
+				expr = fmt.Sprintf("_%s_name_%d", typeName, i)
+			} else {
+				expr = fmt.Sprintf("_%[1]s_name_%[2]d[_%[1]s_index_%[2]d[%[3]d]:_%[1]s_index_%[2]d[%[4]d]]",
+					typeName, i, j, j+1)
 			}
 
-			g.Printf("if i&%s != 0 {\n", &values[0])
-			g.Printf("	i, s = i &^ %s, append(s, _%s_name_%d)\n", &values[0], typeName, i)
-			g.Printf("}\n")
-
-			continue
-		}
-
-		for j, v := range values {
-			if v.value == 0 {
+			if values[j].value == 0 {
+				zero = expr
 				continue
 			}
-
-			g.Printf("if i&%s != 0 {\n", &v)
-			g.Printf("	i, s = i&^ %[5]s, append(s, _%[1]s_name_%[2]d[_%[1]s_index_%[2]d[%[3]d]:_%[1]s_index_%[2]d[%[4]d]])\n",
-				typeName, i, j, j+1, &v)
-			g.Printf("}\n")
+			flags = append(flags, flagName{value: &values[j], expr: expr})
 		}
 	}
 
-	g.buildFlagActiveFlagsMethodEnd(typeName)
+	g.buildFlagMethods(typeName, runs, zero, precompute, func(format string) {
+		for _, f := range flags {
+			g.Printf(format, f.value, f.expr)
+		}
+	})
 }
 
-func (g *Generator) buildFlagStringMethod(typeName string) {
-	g.Printf("\n")
-	g.Printf("func (i %s) String() string {\n", typeName)
-	g.Printf("	return strings.Join(i.ActiveFlags(), \"+\")\n")
-	g.Printf("}\n")
+// flagName is a flag along with the expression naming it in the generated code.
+type flagName struct {
+	value *Value
+	expr  string
 }
 
-func (g *Generator) buildFlagActiveFlagsMethodStart(typeName string, runs [][]Value) {
-	g.Printf("func (i %s) ActiveFlags() []string {\n", typeName)
+// Arguments to format are:
+//
+//	[1]: flag value
+//	[2]: expression naming the flag
+const (
+	stringAppendFlagsCheck = `if i&%[1]s != 0 {
+	i, s = i&^%[1]s, append(s, %[2]s)
+}
+`
+	stringAppendTextCheck = `if i&%[1]s != 0 {
+	if len(b) > n {
+		b = append(b, '+')
+	}
+	i, b = i&^%[1]s, append(b, %[2]s...)
+}
+`
+)
 
-	// Check if any of the runs contains a zero value and return it.
-outer:
-	for i, values := range runs {
-		// Shortcut for single value runs that contains the 0 value.
-		if len(values) == 1 && values[0].value == 0 {
+// buildFlagMethods generates the methods listing the flags set in a value:
+// AppendFlags and AppendText, and ActiveFlags and String built on top of them.
+// zero is the expression naming the zero value, if one is defined, and checks
+// emits the code testing for each flag given one of the check formats above.
+// With precompute, String looks up values made of defined flags only in a
+// table of all their combinations instead.
+func (g *Generator) buildFlagMethods(typeName string, runs [][]Value, zero string, precompute bool, checks func(format string)) {
+	if name := g.method(typeName, "AppendFlags"); name != "" {
+		g.use("strconv")
+		g.Printf("\n")
+		g.Printf("func (i %s) %s(s []string) []string {\n", typeName, name)
+		if zero != "" {
 			g.Printf("if i == 0 {\n")
-			g.Printf("	return []string{_%s_name_%d}\n", typeName, i)
+			g.Printf("	return append(s, %s)\n", zero)
 			g.Printf("}\n\n")
-			break
 		}
+		checks(stringAppendFlagsCheck)
+		g.Printf(stringAppendFlagsEnd[1:], typeName)
+	}
 
-		for j, v := range values {
-			if v.value == 0 {
-				g.Printf("if i == 0 {\n")
-				g.Printf("return []string{_%[1]s_name_%[2]d[_%[1]s_index_%[2]d[%[3]d]:_%[1]s_index_%[2]d[%[4]d]]}\n",
-					typeName,
-					i,
-					j,
-					j+1)
-				g.Printf("}\n\n")
+	if name := g.method(typeName, "ActiveFlags"); name != "" {
+		g.use("math/bits")
+		g.Printf("\n")
+		g.Printf("func (i %s) %s() []string {\n", typeName, name)
+		g.Printf("	return i.%s(make([]string, 0, %s))\n", g.callee(typeName, "AppendFlags"), g.onesCount(typeName))
+		g.Printf("}\n")
+	}
 
-				break outer
-			}
+	if name := g.method(typeName, "AppendText"); name != "" {
+		g.use("strconv")
+		g.Printf("\n")
+		g.Printf("func (i %s) %s(b []byte) ([]byte, error) {\n", typeName, name)
+		if zero != "" {
+			g.Printf("if i == 0 {\n")
+			g.Printf("	return append(b, %s...), nil\n", zero)
+			g.Printf("}\n\n")
 		}
+		g.Printf("n := len(b)\n")
+		checks(stringAppendTextCheck)
+		g.Printf(stringAppendTextEnd[1:], typeName)
 	}
 
-	g.Printf("s := make([]string, 0, bits.OnesCount64(uint64(i)))\n")
+	if name := g.method(typeName, "String"); name != "" {
+		g.Printf("\n")
+		if precompute {
+			g.declareFlagMask(typeName, runs)
+			g.declareFlagCombinations(typeName, runs)
+		}
+		g.Printf("func (i %s) %s() string {\n", typeName, name)
+		if precompute {
+			g.Printf("	if i&^_%s_mask == 0 {\n", typeName)
+			g.Printf("		j := %s\n", flagCombinationIndex(runs))
+			g.Printf("		return _%[1]s_combo_name[_%[1]s_combo_index[j]:_%[1]s_combo_index[j+1]]\n", typeName)
+			g.Printf("	}\n\n")
+		}
+		g.Printf("	var buf [%d]byte\n", flagStringSize(typeName, runs))
+		g.Printf("	b, _ := i.%s(buf[:0])\n", g.callee(typeName, "AppendText"))
+		g.Printf("	return string(b)\n")
+		g.Printf("}\n")
+	}
 }
 
-const stringActiveFlagsEnd = `
+const stringAppendFlagsEnd = `
 	if i != 0 {
 		s = append(s, "%[1]s(" + strconv.FormatInt(int64(i), 10) + ")")
 	}
@@ -569,8 +1020,30 @@ const stringActiveFlagsEnd = `
 }
 `
 
-func (g *Generator) buildFlagActiveFlagsMethodEnd(typeName string) {
-	g.Printf(stringActiveFlagsEnd[1:], typeName)
+const stringAppendTextEnd = `
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "%[1]s("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+`
+
+// flagStringSize returns the size of the buffer the String method of a flag
+// type formats into, which is enough for all flags to be set. Larger values
+// are still formatted correctly, at the cost of an allocation.
+func flagStringSize(typeName string, runs [][]Value) int {
+	n := len(typeName) + len("+()") + len("-9223372036854775808")
+	for _, values := range runs {
+		for _, v := range values {
+			n += len(v.name) + len("+")
+		}
+	}
+	return min(n, 256)
 }
 
 // buildMultipleRuns generates the variables and String method for multiple runs of contiguous values.
@@ -578,7 +1051,12 @@ func (g *Generator) buildFlagActiveFlagsMethodEnd(typeName string) {
 func (g *Generator) buildMultipleRuns(runs [][]Value, typeName string) {
 	g.Printf("\n")
 	g.declareIndexAndNameVars(runs, typeName)
-	g.Printf("func (i %s) String() string {\n", typeName)
+
+	name := g.method(typeName, "String")
+	if name == "" {
+		return
+	}
+	g.Printf("func (i %s) %s() string {\n", typeName, name)
 	g.Printf("\tswitch {\n")
 	for i, values := range runs {
 		if len(values) == 1 {
@@ -599,31 +1077,27 @@ func (g *Generator) buildMultipleRuns(runs [][]Value, typeName string) {
 			typeName, i, typeName, i, typeName, i)
 	}
 	g.Printf("\tdefault:\n")
-	g.Printf("\t\treturn \"%s(\" + strconv.FormatInt(int64(i), 10) + \")\"\n", typeName)
+	g.Printf("\t\treturn %s\n", g.unknownName(typeName, "i"))
 	g.Printf("\t}\n")
 	g.Printf("}\n")
 }
 
-// Argument to format is the type name.
-const stringMap = `func (i %[1]s) String() string {
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: method name
+//	[3]: name of unknown values
+const stringMap = `func (i %[1]s) %[2]s() string {
 	if str, ok := _%[1]s_map[i]; ok {
 		return str
 	}
-	return "%[1]s(" + strconv.FormatInt(int64(i), 10) + ")"
+	return %[3]s
 }
 `
 
-// Argument to format is the type name.
-const stringFlagMap = `for k, v := range _%[1]s_map {
-		if k&i != 0 {
-			i, s = i&^k, append(s, v)
-		}
-	}
-`
-
 // buildMap handles the case where the space is so sparse a map is a reasonable fallback.
 // It's a rare situation but has simple code.
-func (g *Generator) buildMap(typeName string, kind Kind, runs [][]Value) {
+func (g *Generator) buildMap(typeName string, kind Kind, runs [][]Value, precompute bool) {
 	g.Printf("\n")
 	g.declareNameVars(runs, typeName, "")
 
@@ -639,31 +1113,153 @@ func (g *Generator) buildMap(typeName string, kind Kind, runs [][]Value) {
 	g.Printf("}\n\n")
 
 	if kind == Flag {
-		g.buildFlagActiveFlagsMethodStart(typeName, runs)
-		g.Printf(stringFlagMap, typeName)
-		g.buildFlagActiveFlagsMethodEnd(typeName)
-	} else {
-		g.Printf(stringMap, typeName)
+		var zero string
+		for _, values := range runs {
+			if values[0].value == 0 {
+				zero = fmt.Sprintf("_%s_map[0]", typeName)
+			}
+		}
+		g.buildFlagMethods(typeName, runs, zero, precompute, func(format string) {
+			g.Printf("for k, v := range _%s_map {\n", typeName)
+			g.Printf(format, "k", "v")
+			g.Printf("}\n")
+		})
+	} else if name := g.method(typeName, "String"); name != "" {
+		g.Printf(stringMap, typeName, name, g.unknownName(typeName, "i"))
 	}
 }
 
-const stringFlagGetterSetters = `
-func (i %[1]s) %[2]s() bool {return i&%[3]s == %[3]s}
-func (i %[1]s) Set%[2]s() %[1]s {return i|%[3]s}
-func (i %[1]s) Clear%[2]s() %[1]s {return i & ^%[3]s}
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: method name
+//	[3]: name of unknown values
+const stringSearch = `func (i %[1]s) %[2]s() string {
+	lo, hi := 0, len(_%[1]s_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _%[1]s_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_%[1]s_values) && _%[1]s_values[lo] == i {
+		return _%[1]s_name[_%[1]s_index[lo]:_%[1]s_index[lo+1]]
+	}
+	return %[3]s
+}
 `
 
-func (g *Generator) buildFlagGetterSetters(typeName string, values []Value) {
+// buildSearch handles sparse values by searching a sorted array of them.
+// Unlike a map, the arrays need no initialization at run time.
+func (g *Generator) buildSearch(typeName string, kind Kind, runs [][]Value, precompute bool) {
+	g.Printf("\n")
+	g.declareNameVars(runs, typeName, "")
+	g.declareValues(typeName, runs)
+
+	var zero string
+	var indexes []string
+	n := 0
+	for _, values := range runs {
+		for _, value := range values {
+			if value.value == 0 {
+				zero = fmt.Sprintf("_%s_name[%d:%d]", typeName, n, n+len(value.name))
+			}
+			n += len(value.name)
+			indexes = append(indexes, strconv.Itoa(n))
+		}
+	}
+	g.Printf("\nvar _%s_index = [...]uint%d{0, %s}\n\n", typeName, usize(n), strings.Join(indexes, ", "))
+
+	if kind == Flag {
+		g.buildFlagMethods(typeName, runs, zero, precompute, func(format string) {
+			g.Printf("for j, v := range _%s_values {\n", typeName)
+			g.Printf(format, "v", fmt.Sprintf("_%[1]s_name[_%[1]s_index[j]:_%[1]s_index[j+1]]", typeName))
+			g.Printf("}\n")
+		})
+	} else if name := g.method(typeName, "String"); name != "" {
+		g.Printf(stringSearch, typeName, name, g.unknownName(typeName, "i"))
+	}
+}
+
+// declareValues declares the array holding the values of all runs in
+// increasing order.
+func (g *Generator) declareValues(typeName string, runs [][]Value) {
+	if g.emitted["values"] {
+		return
+	}
+	g.emitted["values"] = true
+
+	g.Printf("\nvar _%s_values = [...]%s{", typeName, typeName)
+	for i, values := range runs {
+		for j := range values {
+			if i > 0 || j > 0 {
+				g.Printf(", ")
+			}
+			g.Printf("%s", &values[j])
+		}
+	}
+	g.Printf("}\n")
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: method name
+//	[3]: constant name
+const (
+	stringFlagGetter = "func (i %[1]s) %[2]s() bool {return i&%[3]s == %[3]s}\n"
+	stringFlagSetter = "func (i %[1]s) %[2]s() %[1]s {return i|%[3]s}\n"
+	stringFlagClear  = "func (i %[1]s) %[2]s() %[1]s {return i & ^%[3]s}\n"
+	stringFlagToggle = "func (i %[1]s) %[2]s() %[1]s {return i ^ %[3]s}\n"
+
+	stringFlagPointerSetter = "func (i *%[1]s) %[2]s(v bool) {if v {*i |= %[3]s} else {*i &^= %[3]s}}\n"
+	stringFlagPointerClear  = "func (i *%[1]s) %[2]s() {*i &^= %[3]s}\n"
+	stringFlagPointerToggle = "func (i *%[1]s) %[2]s() {*i ^= %[3]s}\n"
+)
+
+func (g *Generator) buildFlagGetterSetters(typeName string, values []Value, opts typeOptions) {
+	setter, clear, toggle := stringFlagSetter, stringFlagClear, stringFlagToggle
+	if opts.pointerSetters {
+		setter, clear, toggle = stringFlagPointerSetter, stringFlagPointerClear, stringFlagPointerToggle
+	}
+
 	for _, value := range values {
+		g.Printf("\n")
 		if value.value == 0 {
-			g.Printf("\nfunc (i %[1]s) %[2]s() bool {return i == 0}\n", typeName, value.name)
+			if name := g.accessor(typeName, opts.getterName, value); name != "" {
+				g.Printf("func (i %[1]s) %[2]s() bool {return i == 0}\n", typeName, name)
+			}
 
 			continue
 		}
-		g.Printf(stringFlagGetterSetters, typeName, value.name, value.originalName)
+		if name := g.accessor(typeName, opts.getterName, value); name != "" {
+			g.Printf(stringFlagGetter, typeName, name, value.originalName)
+		}
+		if name := g.accessor(typeName, opts.setterName, value); name != "" {
+			g.Printf(setter, typeName, name, value.originalName)
+		}
+		if name := g.accessor(typeName, opts.clearName, value); name != "" {
+			g.Printf(clear, typeName, name, value.originalName)
+		}
+		if name := g.accessor(typeName, opts.toggleName, value); name != "" {
+			g.Printf(toggle, typeName, name, value.originalName)
+		}
 	}
 }
 
+// accessor returns the method name for the flag value following the naming
+// template, with {} standing for the line comment or the trimmed constant
+// name whatever transform or directive String follows, or an empty string if
+// there is no such method.
+func (g *Generator) accessor(typeName, template string, value Value) string {
+	if template == "" {
+		return ""
+	}
+	return g.method(typeName, strings.ReplaceAll(template, "{}", value.accessor))
+}
+
 func (g *Generator) findTypeDeclarationFile(typeName string) (string, error) {
 	for _, pkg := range g.pkgs {
 		for ident, obj := range pkg.defs {
@@ -679,3 +1275,186 @@ func (g *Generator) findTypeDeclarationFile(typeName string) (string, error) {
 
 	return "", fmt.Errorf("type %q not found in loaded packages", typeName)
 }
+
+// flagSetOps are the set operations generated for flag types. Arguments to
+// format are:
+//
+//	[1]: type name
+//	[2]: method name
+//	[3]: expression counting the bits set in i
+var flagSetOps = []struct {
+	name, format string
+}{
+	{"Has", `// %[2]s reports whether the flags of f are set in i. Unlike HasAll(0),
+// which is always true, %[2]s(0) reports whether no flag is set.
+func (i %[1]s) %[2]s(f %[1]s) bool {
+	if f == 0 {
+		return i == 0
+	}
+	return i&f == f
+}
+`},
+	{"HasAny", "func (i %[1]s) %[2]s(f %[1]s) bool {return i&f != 0}\n"},
+	{"HasAll", "func (i %[1]s) %[2]s(f %[1]s) bool {return i&f == f}\n"},
+	{"Union", "func (i %[1]s) %[2]s(f %[1]s) %[1]s {return i | f}\n"},
+	{"Intersect", "func (i %[1]s) %[2]s(f %[1]s) %[1]s {return i & f}\n"},
+	{"Without", "func (i %[1]s) %[2]s(f %[1]s) %[1]s {return i &^ f}\n"},
+	{"Toggle", "func (i %[1]s) %[2]s(f %[1]s) %[1]s {return i ^ f}\n"},
+	{"Count", "func (i %[1]s) %[2]s() int {return %[3]s}\n"},
+	{"IsZero", "func (i %[1]s) %[2]s() bool {return i == 0}\n"},
+	{"Known", "func (i %[1]s) %[2]s() %[1]s {return i & _%[1]s_mask}\n"},
+	{"Unknown", "func (i %[1]s) %[2]s() %[1]s {return i &^ _%[1]s_mask}\n"},
+}
+
+// buildFlagSetOps generates the set operations for a flag type. Known and
+// Unknown split a value along the mask of the flags defined in runs.
+func (g *Generator) buildFlagSetOps(typeName string, runs [][]Value) {
+	g.Printf("\n")
+	g.declareFlagMask(typeName, runs)
+
+	for _, op := range flagSetOps {
+		name := g.method(typeName, op.name)
+		if name == "" {
+			continue
+		}
+		if op.name == "Count" {
+			g.use("math/bits")
+		}
+		g.Printf("\n")
+		g.Printf(op.format, typeName, name, g.onesCount(typeName))
+	}
+}
+
+// onesCount returns the expression counting the bits set in a value i of
+// the named type, converted to the unsigned type of the same size so that
+// negative values are not sign-extended.
+func (g *Generator) onesCount(typeName string) string {
+	for _, pkg := range g.pkgs {
+		obj, ok := pkg.types.Scope().Lookup(typeName).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		basic, ok := obj.Type().Underlying().(*types.Basic)
+		if !ok {
+			break
+		}
+		switch basic.Kind() {
+		case types.Int8, types.Uint8:
+			return "bits.OnesCount8(uint8(i))"
+		case types.Int16, types.Uint16:
+			return "bits.OnesCount16(uint16(i))"
+		case types.Int32, types.Uint32:
+			return "bits.OnesCount32(uint32(i))"
+		case types.Int, types.Uint:
+			return "bits.OnesCount(uint(i))"
+		}
+	}
+	return "bits.OnesCount64(uint64(i))"
+}
+
+// declareFlagMask declares the constant holding the union of all flags
+// defined in runs.
+func (g *Generator) declareFlagMask(typeName string, runs [][]Value) {
+	if g.emitted["mask"] {
+		return
+	}
+	g.emitted["mask"] = true
+
+	var mask uint64
+	for _, values := range runs {
+		for _, v := range values {
+			mask |= v.value
+		}
+	}
+
+	str := fmt.Sprintf("%#x", mask)
+	if runs[0][0].signed && int64(mask) < 0 {
+		str = strconv.FormatInt(int64(mask), 10)
+	}
+	g.Printf("const _%s_mask %s = %s\n", typeName, typeName, str)
+}
+
+// countFlags returns the number of flags other than zero defined in runs.
+func countFlags(runs [][]Value) int {
+	n := 0
+	for _, values := range runs {
+		for _, v := range values {
+			if v.value != 0 {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// declareFlagCombinations declares the concatenated names of all
+// combinations of the flags defined in runs, along with the index slice
+// into them. Combination j is made of the flags whose position in the
+// sorted list of flags is set in j.
+func (g *Generator) declareFlagCombinations(typeName string, runs [][]Value) {
+	var zero string
+	var flags []string
+	for _, values := range runs {
+		for _, v := range values {
+			if v.value == 0 {
+				zero = v.name
+				continue
+			}
+			flags = append(flags, v.name)
+		}
+	}
+
+	b := new(bytes.Buffer)
+	indexes := make([]int, 1<<len(flags))
+	for j := range indexes {
+		start := b.Len()
+		if j == 0 {
+			b.WriteString(zero)
+		}
+		for k, flag := range flags {
+			if j&(1<<k) == 0 {
+				continue
+			}
+			if b.Len() > start {
+				b.WriteByte('+')
+			}
+			b.WriteString(flag)
+		}
+		indexes[j] = b.Len()
+	}
+
+	g.Printf("const _%s_combo_name = %q\n\n", typeName, b.String())
+	g.Printf("var _%s_combo_index = [...]uint%d{0", typeName, usize(b.Len()))
+	for _, index := range indexes {
+		g.Printf(", %d", index)
+	}
+	g.Printf("}\n\n")
+}
+
+// flagCombinationIndex returns the expression computing the position of a
+// value made of the flags defined in runs in the table declared by
+// declareFlagCombinations. Each run of flags is shifted into place at once.
+func flagCombinationIndex(runs [][]Value) string {
+	var terms []string
+	k := 0
+	for _, values := range runs {
+		if values[0].value == 0 {
+			values = values[1:]
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		term := "uint64(i)"
+		if shift := bits.TrailingZeros64(values[0].value); shift > 0 {
+			term += fmt.Sprintf(">>%d", shift)
+		}
+		term += fmt.Sprintf("&%#x", uint64(1)<<len(values)-1)
+		if k > 0 {
+			term += fmt.Sprintf("<<%d", k)
+		}
+		terms = append(terms, term)
+		k += len(values)
+	}
+	return strings.Join(terms, " | ")
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+const checkInput = `package test
+
+type Check uint
+
+const (
+	Read Check = 1 << iota
+	Write
+)
+`
+
+const checkMethod = `package test
+
+func (c Check) String() string { return "check" }
+`
+
+func TestCheck(t *testing.T) {
+	dir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "check.go"), []byte(checkInput), 0644))
+
+	// A file declaring the same method, which is either a previous output
+	// file or a hand-written one depending on the output name.
+	methodFile := filepath.Join(dir, "method.go")
+	assert.NilError(t, os.WriteFile(methodFile, []byte(checkMethod), 0644))
+
+	g := Generator{outputName: methodFile}
+	assert.NilError(t, g.parsePackage([]string{filepath.Join(dir, "check.go"), methodFile}, nil))
+	g.generate(*newTypeOptions(Flag, "Check"))
+
+	src, err := g.format()
+	assert.NilError(t, err)
+
+	assert.NilError(t, g.check(src, methodFile))
+	assert.ErrorContains(t, g.check(src, filepath.Join(dir, "check_string.go")), "String")
+}
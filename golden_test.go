@@ -16,14 +16,73 @@ func TestGolden(t *testing.T) {
 		lineComment  bool
 		bitFlags     bool
 		getterSetter bool
+		options      string
+		catalogs     string // Directory of message catalogs in testdata.
 	}{
 		{name: "day", bitFlags: true},
 		{name: "gap", bitFlags: true},
 		{name: "zero", bitFlags: true},
 		{name: "compound", bitFlags: true},
 		{name: "multirun", bitFlags: true},
+		{name: "sparse", bitFlags: true, trimPrefix: "Sparse"},
 		{name: "trimmed", bitFlags: true, trimPrefix: "Trimmed"},
 		{name: "getterSetter", bitFlags: true, trimPrefix: "GetterSetter", getterSetter: true},
+		{name: "search", trimPrefix: "Search"},
+		{name: "map", trimPrefix: "Map", options: "layout:map"},
+		{name: "forced", trimPrefix: "Forced", options: "layout:search"},
+		{name: "sparseSwitch", bitFlags: true, trimPrefix: "SparseSwitch", options: "layout:switch"},
+		{name: "sizeEnum", trimPrefix: "SizeEnum", options: "optimize:size"},
+		{name: "sizeFlags", bitFlags: true, trimPrefix: "SizeFlags", options: "optimize:size;precompute"},
+		{name: "rename", bitFlags: true, trimPrefix: "Rename", getterSetter: true, options: "conflict:rename"},
+		{name: "skip", bitFlags: true, trimPrefix: "Skip", getterSetter: true, options: "conflict:skip"},
+		{name: "accessors", bitFlags: true, trimPrefix: "Accessors", getterSetter: true,
+			options: "getter:Is{};setter:With{};clear:Without{};toggle:Toggle{}"},
+		{name: "precompute", bitFlags: true, trimPrefix: "Precompute", options: "precompute"},
+		{name: "setOps", bitFlags: true, trimPrefix: "SetOps", options: "setOps"},
+		{name: "setOpsSigned", bitFlags: true, trimPrefix: "SetOpsSigned", options: "setOps"},
+		{name: "pointerSetters", bitFlags: true, trimPrefix: "PointerSetters", getterSetter: true,
+			options: "receiver:pointer;toggle:Toggle{};clear:"},
+		{name: "parse", trimPrefix: "Parse", options: "parse"},
+		{name: "parseFlags", bitFlags: true, trimPrefix: "ParseFlags", options: "parse"},
+		{name: "parseShared", trimPrefix: "ParseShared", options: "parse"},
+		{name: "parseSize", trimPrefix: "ParseSize", options: "parse;optimize:size"},
+		{name: "aliasSize", trimPrefix: "AliasSize", options: "parse;optimize:size"},
+		{name: "parseFold", trimPrefix: "ParseFold", options: "parseFold;parsePrefix"},
+		{name: "parseFoldAlias", trimPrefix: "ParseFoldAlias", options: "parseFold"},
+		{name: "parsePrefix", bitFlags: true, trimPrefix: "ParsePrefix", options: "parsePrefix"},
+		{name: "directives", trimPrefix: "Directives", lineComment: true, options: "parse"},
+		{name: "formats", trimPrefix: "Formats", options: "text;json"},
+		{name: "deprecatedFormats", trimPrefix: "DeprecatedFormats", options: "json;deprecated"},
+		{name: "marshalFlags", bitFlags: true, trimPrefix: "MarshalFlags", options: "text;json"},
+		{name: "description", trimPrefix: "Description", options: "description"},
+		{name: "meta", trimPrefix: "Meta"},
+		{name: "deprecated", trimPrefix: "Deprecated", options: "parse;deprecated;values"},
+		{name: "deprecatedFlags", bitFlags: true, trimPrefix: "DeprecatedFlags", options: "parse;deprecated;values:all"},
+		{name: "transform", trimPrefix: "Transform", lineComment: true, options: "transform:kebab;parse"},
+		{name: "transformGetters", bitFlags: true, trimPrefix: "TransformGetters", getterSetter: true, options: "transform:kebab"},
+		{name: "lineCommentGetters", bitFlags: true, trimPrefix: "LineCommentGetters", lineComment: true, getterSetter: true},
+		{name: "lenient", trimPrefix: "Lenient", options: "parse;text;json;lenient;stringDefault"},
+		{name: "lenientLast", trimPrefix: "LenientLast", options: "parse;text;json;lenient;stringDefault"},
+		{name: "stringDefault", trimPrefix: "StringDefault", options: "layout:search;stringDefault"},
+		{name: "navigation", trimPrefix: "Navigation", options: "navigation"},
+		{name: "navigationSearch", trimPrefix: "NavigationSearch", options: "navigation;layout:search"},
+		{name: "navigationRename", trimPrefix: "NavigationRename", options: "navigation;conflict:rename"},
+		{name: "identSkip", trimPrefix: "IdentSkip", options: "parse;text;values;set;arrayMap;conflict:skip"},
+		{name: "identRename", trimPrefix: "IdentRename", options: "parse;text;values;set;arrayMap;conflict:rename"},
+		{name: "set", trimPrefix: "Set", options: "set"},
+		{name: "setLarge", trimPrefix: "SetLarge", options: "set"},
+		{name: "lenientSet", trimPrefix: "LenientSet", options: "set;lenient"},
+		{name: "enumMap", trimPrefix: "EnumMap", options: "arrayMap"},
+		{name: "lenientMap", trimPrefix: "LenientMap", options: "arrayMap;lenient"},
+		{name: "transitions", trimPrefix: "Transitions"},
+		{name: "transitionsAlias", trimPrefix: "TransitionsAlias"},
+		{name: "errorCode", trimPrefix: "ErrorCode", options: "error"},
+		{name: "errorDescription", trimPrefix: "ErrorDescription", options: "error;description"},
+		{name: "errorFormat", trimPrefix: "ErrorFormat", options: "error;format"},
+		{name: "logging", trimPrefix: "Logging", options: "slog;format"},
+		{name: "loggingFlags", bitFlags: true, trimPrefix: "LoggingFlags", options: "slog;format"},
+		{name: "loggingSigned", bitFlags: true, trimPrefix: "LoggingSigned", options: "slog"},
+		{name: "i18n", trimPrefix: "I18n", catalogs: "i18n"},
 	}
 
 	dir := t.TempDir()
@@ -38,6 +97,11 @@ func TestGolden(t *testing.T) {
 			}
 
 			g := Generator{}
+			if tc.catalogs != "" {
+				if g.catalogs, err = loadCatalogs(filepath.Join("testdata", tc.catalogs)); err != nil {
+					t.Fatal(err)
+				}
+			}
 			if err := g.parsePackage([]string{absFile}, nil); err != nil {
 				t.Fatal(err)
 			}
@@ -52,15 +116,31 @@ func TestGolden(t *testing.T) {
 			}
 
 			g.parsePackage([]string{absFile}, nil)
-			g.generateStart(tc.bitFlags)
 			k := Enum
 			if tc.bitFlags {
 				k = Flag
 			}
-			g.generate(tokens[3], k, tc.trimPrefix, tc.lineComment, tc.getterSetter)
-			got := string(g.format())
+			opts := newTypeOptions(k, tokens[3])
+			opts.trimPrefix = tc.trimPrefix
+			opts.lineComment = tc.lineComment
+			opts.getterSetter = tc.getterSetter
+			if err := opts.parse(tc.options); err != nil {
+				t.Fatal(err)
+			}
+			g.generate(*opts)
+			src, err := g.format()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := g.check(src, filepath.Join(dir, tc.name+"_string.go")); err != nil {
+				t.Fatal(err)
+			}
 
-			golden.Assert(t, got, tc.name+".out.go")
+			golden.Assert(t, string(src), tc.name+".out.go")
+			if g.graphs != nil {
+				golden.Assert(t, string(dot(g.graphs)), tc.name+".dot")
+				golden.Assert(t, string(mermaid(g.graphs)), tc.name+".mmd")
+			}
 		})
 	}
 }
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// catalogs holds the translations of constant names by language, read
+// from a directory of message catalogs.
+type catalogs map[string]map[string]string
+
+// loadCatalogs reads the message catalogs in dir, one per language named
+// after the file: de.json holds a JSON object mapping constant names to
+// their German translations, and fr.po the French translations in gettext
+// format, with constant names as message ids. Languages are keyed by their
+// tag in canonical form, so pt_BR.po holds the translations for "pt-br".
+func loadCatalogs(dir string) (catalogs, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	c := make(catalogs)
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		lang := canonLang(strings.TrimSuffix(e.Name(), ext))
+		if e.IsDir() || ext != ".json" && ext != ".po" {
+			continue
+		}
+		if _, ok := c[lang]; ok {
+			return nil, fmt.Errorf("%s: more than one catalog for %s", dir, lang)
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var messages map[string]string
+		if ext == ".json" {
+			err = json.Unmarshal(data, &messages)
+		} else {
+			messages, err = parsePO(data)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Join(dir, e.Name()), err)
+		}
+		c[lang] = messages
+	}
+	return c, nil
+}
+
+// parsePO parses the messages of a gettext catalog. Fuzzy and untranslated
+// messages are left out, and so are plural forms.
+func parsePO(data []byte) (map[string]string, error) {
+	messages := make(map[string]string)
+	var (
+		keyword       string // Keyword the strings being read belong to.
+		msgid, msgstr string
+		fuzzy         bool
+		pending       bool // Whether a message is being read.
+	)
+	flush := func() {
+		if msgid != "" && msgstr != "" && !fuzzy {
+			messages[msgid] = msgstr
+		}
+		msgid, msgstr, fuzzy, pending = "", "", false, false
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#"):
+			// Comments precede the message they are about.
+			if pending {
+				flush()
+			}
+			fuzzy = fuzzy || strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy")
+			continue
+		case !strings.HasPrefix(line, `"`):
+			var rest string
+			keyword, rest, _ = strings.Cut(line, " ")
+			if keyword == "msgid" {
+				if pending {
+					flush()
+				}
+				pending = true
+			}
+			line = strings.TrimSpace(rest)
+		}
+
+		str, err := strconv.Unquote(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid string %s", n, line)
+		}
+		switch keyword {
+		case "msgid":
+			msgid += str
+		case "msgstr":
+			msgstr += str
+		}
+	}
+	flush()
+	return messages, s.Err()
+}
+
+// buildLocalized generates the Localized method returning the translation of
+// an enum value, indexing one table per language. Missing translations are
+// reported.
+func (g *Generator) buildLocalized(typeName string, kind Kind, layout Layout, runs [][]Value) {
+	var langs []string
+	for lang, messages := range g.catalogs {
+		if translated(messages, runs) {
+			langs = append(langs, lang)
+		}
+	}
+	if len(langs) == 0 {
+		return
+	}
+	if kind == Flag {
+		log.Printf("warning: type %s: translations are only supported for enums", typeName)
+		return
+	}
+	sort.Strings(langs)
+
+	g.declareOrdinal(typeName, kind, runs, layout)
+	for _, lang := range langs {
+		messages := g.catalogs[lang]
+		for _, values := range runs {
+			for _, v := range values {
+				if messages[v.originalName] == "" {
+					log.Printf("warning: type %s: no %s translation for %s", typeName, lang, v.originalName)
+				}
+			}
+		}
+		table := "l10n_" + langIdent(lang)
+		g.declareValueTable(typeName, table, table+"_index", runs, func(v *Value) string {
+			return messages[v.originalName]
+		})
+	}
+
+	name := g.method(typeName, "Localized")
+	if name == "" {
+		return
+	}
+	g.use("strings")
+	g.Printf("\n// %s returns the name of i in the language lang, such as \"de\" or\n", name)
+	g.Printf("// \"de-AT\", falling back to the language without region and to String.\n")
+	g.Printf("// Language tags match regardless of case and of subtags being separated\n")
+	g.Printf("// by '-' or '_', so \"pt_BR\" and \"PT-br\" both stand for \"pt-BR\".\n")
+	g.Printf("func (i %s) %s(lang string) string {\n", typeName, name)
+	g.Printf("	j := _%s_ordinal(i)\n", typeName)
+	g.Printf("	if j < 0 {\n")
+	g.Printf("		return i.%s()\n", g.callee(typeName, "String"))
+	g.Printf("	}\n\n")
+	g.Printf("	lang = strings.ToLower(strings.ReplaceAll(lang, \"_\", \"-\"))\n")
+	g.Printf("	var s string\n")
+	g.Printf("	switch lang {\n")
+	for _, lang := range langs {
+		g.Printf("	case %q:\n", lang)
+		g.Printf("		s = _%[1]s_l10n_%[2]s[_%[1]s_l10n_%[2]s_index[j]:_%[1]s_l10n_%[2]s_index[j+1]]\n", typeName, langIdent(lang))
+	}
+	g.Printf("	}\n")
+	g.Printf("	if s != \"\" {\n")
+	g.Printf("		return s\n")
+	g.Printf("	}\n")
+	g.Printf("	if k := strings.IndexByte(lang, '-'); k > 0 {\n")
+	g.Printf("		return i.%s(lang[:k])\n", name)
+	g.Printf("	}\n")
+	g.Printf("	return i.%s()\n", g.callee(typeName, "String"))
+	g.Printf("}\n")
+}
+
+// translated reports whether any of the values of runs has a translation
+// among messages.
+func translated(messages map[string]string, runs [][]Value) bool {
+	for _, values := range runs {
+		for _, v := range values {
+			if messages[v.originalName] != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// canonLang returns the language tag in the form Localized compares tags
+// in: lower case, with subtags separated by '-'.
+func canonLang(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+}
+
+// langIdent returns the language tag made fit for an identifier.
+func langIdent(lang string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '.' {
+			return '_'
+		}
+		return r
+	}, lang)
+}
+
+// checkCatalogs reports the keys of the catalogs naming no constant of the
+// package, left behind by constants that were renamed or removed.
+func (g *Generator) checkCatalogs() {
+	var stale []string
+	for lang, messages := range g.catalogs {
+		for key := range messages {
+			if _, ok := g.pkgs[0].types.Scope().Lookup(key).(*types.Const); !ok {
+				stale = append(stale, fmt.Sprintf("%s in %s", key, lang))
+			}
+		}
+	}
+	sort.Strings(stale)
+	for _, s := range stale {
+		log.Printf("warning: stale translation of %s", s)
+	}
+}
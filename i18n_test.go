@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParsePO(t *testing.T) {
+	messages, err := parsePO([]byte(`msgid ""
+msgstr "Language: fr\n"
+
+# Translator comment.
+msgid "Red"
+msgstr "Rouge"
+
+#, fuzzy
+msgid "Blue"
+msgstr "Bleu"
+msgid "Green"
+msgstr ""
+"Ve"
+"rt"
+
+msgid "Black"
+msgstr ""
+`))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, messages, map[string]string{"Red": "Rouge", "Green": "Vert"})
+
+	_, err = parsePO([]byte("msgid Red\n"))
+	assert.ErrorContains(t, err, "line 1: invalid string")
+}
+
+func TestLoadCatalogs(t *testing.T) {
+	c, err := loadCatalogs(filepath.Join("testdata", "i18n"))
+	assert.NilError(t, err)
+	assert.Equal(t, len(c), 3)
+	assert.Equal(t, c["de"]["I18nGreen"], "Grün")
+	assert.Equal(t, c["fr"]["I18nBlack"], "Noir")
+	assert.Equal(t, c["pt-br"]["I18nRed"], "Vermelho")
+	assert.Equal(t, langIdent("pt-br"), "pt_br")
+	assert.Equal(t, canonLang("pt_BR"), "pt-br")
+}
+
+const colorInput = `package test
+
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+`
+
+func TestCatalogWarnings(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "color.go")
+	assert.NilError(t, os.WriteFile(input, []byte(colorInput), 0644))
+
+	var out bytes.Buffer
+	log.SetOutput(&out)
+	defer log.SetOutput(os.Stderr)
+
+	g := Generator{catalogs: catalogs{"de": {"Red": "Rot", "Green": "Grün", "Purple": "Lila"}}}
+	assert.NilError(t, g.parsePackage([]string{input}, nil))
+	g.checkCatalogs()
+	g.generate(*newTypeOptions(Enum, "Color"))
+
+	assert.Assert(t, strings.Contains(out.String(), "warning: stale translation of Purple in de"), out.String())
+	assert.Assert(t, strings.Contains(out.String(), "warning: type Color: no de translation for Blue"), out.String())
+	assert.Assert(t, !strings.Contains(out.String(), "for Red"), out.String())
+}
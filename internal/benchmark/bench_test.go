@@ -0,0 +1,197 @@
+package benchmark
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+// The results of the benchmarked calls are stored in sinks so that the
+// compiler cannot drop them along with their allocations.
+var (
+	sink       string
+	bytesSink  []byte
+	statusSink Status
+)
+
+func BenchmarkString(b *testing.B) {
+	b.Run("legacy", func(b *testing.B) {
+		b.ReportAllocs()
+		v := LegacyPermRead | LegacyPermExecute | LegacyPermSetGID
+		for b.Loop() {
+			sink = v.String()
+		}
+	})
+
+	b.Run("append", func(b *testing.B) {
+		b.ReportAllocs()
+		v := PermRead | PermExecute | PermSetGID
+		for b.Loop() {
+			sink = v.String()
+		}
+	})
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	b.ReportAllocs()
+	v := PermRead | PermExecute | PermSetGID
+	buf := make([]byte, 0, 64)
+	for b.Loop() {
+		buf, _ = v.AppendText(buf[:0])
+	}
+	bytesSink = buf
+}
+
+// TestString makes sure both String methods agree.
+func TestString(t *testing.T) {
+	for v := range Perm(1 << 7) {
+		want := strings.ReplaceAll(LegacyPerm(v).String(), "LegacyPerm", "Perm")
+		if got := v.String(); got != want {
+			t.Errorf("Perm(%d).String() = %q, want %q", v, got, want)
+		}
+	}
+}
+
+func BenchmarkPrecomputed(b *testing.B) {
+	b.Run("append", func(b *testing.B) {
+		b.ReportAllocs()
+		v := PermRead | PermExecute | PermSetGID
+		for b.Loop() {
+			sink = v.String()
+		}
+	})
+
+	b.Run("table", func(b *testing.B) {
+		b.ReportAllocs()
+		v := ProtoSYN | ProtoACK | ProtoURG
+		for b.Loop() {
+			sink = v.String()
+		}
+	})
+}
+
+// TestPrecomputed makes sure the table agrees with AppendText.
+func TestPrecomputed(t *testing.T) {
+	for n := range 256 {
+		v := Proto(n)
+		want, _ := v.AppendText(nil)
+		if got := v.String(); got != string(want) {
+			t.Errorf("Proto(%d).String() = %q, want %q", v, got, want)
+		}
+	}
+}
+
+var statuses = []Status{
+	StatusContinue, StatusOK, StatusNoContent, StatusNotModified,
+	StatusNotFound, StatusConflict, StatusUnavailable, Status(999),
+}
+
+func BenchmarkSparse(b *testing.B) {
+	b.Run("search", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			for _, v := range statuses[:len(statuses)-1] {
+				sink = v.String()
+			}
+		}
+	})
+
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			for _, v := range statuses[:len(statuses)-1] {
+				sink = MapStatus(v).String()
+			}
+		}
+	})
+}
+
+// TestSparse makes sure the search and map layouts agree.
+func TestSparse(t *testing.T) {
+	for _, v := range statuses {
+		want := strings.ReplaceAll(MapStatus(v).String(), "MapStatus", "Status")
+		if got := v.String(); got != want {
+			t.Errorf("Status(%d).String() = %q, want %q", v, got, want)
+		}
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	names := make([]string, len(statuses)-1)
+	byName := make(map[string]Status)
+	for i, v := range statuses[:len(statuses)-1] {
+		names[i] = v.String()
+		byName[names[i]] = v
+	}
+
+	b.Run("switch", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			for _, s := range names {
+				statusSink, _ = ParseStatus(s)
+			}
+		}
+	})
+
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			for _, s := range names {
+				statusSink = byName[s]
+			}
+		}
+	})
+}
+
+// TestParse makes sure ParseStatus inverts String.
+func TestParse(t *testing.T) {
+	for _, v := range statuses[:len(statuses)-1] {
+		if got, err := ParseStatus(v.String()); got != v || err != nil {
+			t.Errorf("ParseStatus(%q) = %v, %v, want %v", v.String(), got, err, v)
+		}
+	}
+	_, err := ParseStatus("Teapot")
+	if !errors.Is(err, enum.ErrUnknownName) {
+		t.Errorf("ParseStatus(%q) = %v, want %v", "Teapot", err, enum.ErrUnknownName)
+	}
+
+	// Errors list copies of the names, which callers may modify.
+	var perr *enum.ParseError
+	if errors.As(err, &perr) {
+		clear(perr.Valid)
+	}
+	_, err = ParseStatus("Teapot")
+	if errors.As(err, &perr) && perr.Valid[0] != StatusContinue.String() {
+		t.Errorf("ParseStatus(%q) lists %q after editing a previous error", "Teapot", perr.Valid)
+	}
+}
+
+// TestMarshal makes sure the marshalers round-trip the values of Status
+// and refuse the others, which they could not unmarshal again.
+func TestMarshal(t *testing.T) {
+	for _, v := range statuses[:len(statuses)-1] {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v) = %v", v, err)
+		}
+		var got Status
+		if err := json.Unmarshal(b, &got); got != v || err != nil {
+			t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", b, got, err, v)
+		}
+		text, _ := v.MarshalText()
+		if err := got.UnmarshalText(text); got != v || err != nil {
+			t.Errorf("UnmarshalText(%s) = %v, %v, want %v", text, got, err, v)
+		}
+	}
+
+	unknown := statuses[len(statuses)-1]
+	if _, err := json.Marshal(unknown); !errors.Is(err, enum.ErrUnknownValue) {
+		t.Errorf("json.Marshal(%v) = %v, want %v", unknown, err, enum.ErrUnknownValue)
+	}
+	if _, err := unknown.MarshalText(); !errors.Is(err, enum.ErrUnknownValue) {
+		t.Errorf("MarshalText(%v) = %v, want %v", unknown, err, enum.ErrUnknownValue)
+	}
+}
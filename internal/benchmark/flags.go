@@ -0,0 +1,42 @@
+// Package benchmark measures the performance of the generated code.
+package benchmark
+
+//go:generate go run ../.. -output=perm_string.go "-flags=Perm=trimType,Proto=trimType;precompute"
+
+// Perm is a flag type using the current generator output.
+type Perm uint8
+
+const (
+	PermRead Perm = 1 << iota
+	PermWrite
+	PermExecute
+	PermSticky
+	PermSetUID
+	PermSetGID
+)
+
+// LegacyPerm is a flag type whose String method joins the result of
+// ActiveFlags, as the generator did before AppendText was added.
+type LegacyPerm uint8
+
+const (
+	LegacyPermRead LegacyPerm = 1 << iota
+	LegacyPermWrite
+	LegacyPermExecute
+	LegacyPermSticky
+	LegacyPermSetUID
+	LegacyPermSetGID
+)
+
+// Proto is a small flag type whose String method uses a table of all
+// combinations of flags.
+type Proto uint8
+
+const (
+	ProtoNone Proto = 0
+	ProtoSYN  Proto = 1 << iota
+	ProtoACK
+	ProtoFIN
+	ProtoRST Proto = 1 << 6
+	ProtoURG Proto = 1 << 7
+)
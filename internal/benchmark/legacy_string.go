@@ -0,0 +1,60 @@
+// This file holds the String method generated for flag types before the
+// introduction of AppendText, kept for comparison. Do not regenerate it.
+
+package benchmark
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LegacyPermRead-1]
+	_ = x[LegacyPermWrite-2]
+	_ = x[LegacyPermExecute-4]
+	_ = x[LegacyPermSticky-8]
+	_ = x[LegacyPermSetUID-16]
+	_ = x[LegacyPermSetGID-32]
+}
+
+const (
+	_LegacyPerm_name_0 = "ReadWriteExecuteStickySetUIDSetGID"
+)
+
+var (
+	_LegacyPerm_index_0 = [...]uint8{0, 4, 9, 16, 22, 28, 34}
+)
+
+func (i LegacyPerm) ActiveFlags() []string {
+	s := make([]string, 0, bits.OnesCount64(uint64(i)))
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _LegacyPerm_name_0[_LegacyPerm_index_0[0]:_LegacyPerm_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _LegacyPerm_name_0[_LegacyPerm_index_0[1]:_LegacyPerm_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _LegacyPerm_name_0[_LegacyPerm_index_0[2]:_LegacyPerm_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _LegacyPerm_name_0[_LegacyPerm_index_0[3]:_LegacyPerm_index_0[4]])
+	}
+	if i&16 != 0 {
+		i, s = i&^16, append(s, _LegacyPerm_name_0[_LegacyPerm_index_0[4]:_LegacyPerm_index_0[5]])
+	}
+	if i&32 != 0 {
+		i, s = i&^32, append(s, _LegacyPerm_name_0[_LegacyPerm_index_0[5]:_LegacyPerm_index_0[6]])
+	}
+	if i != 0 {
+		s = append(s, "LegacyPerm("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i LegacyPerm) String() string {
+	return strings.Join(i.ActiveFlags(), "+")
+}
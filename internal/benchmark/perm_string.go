@@ -0,0 +1,225 @@
+package benchmark
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[PermRead-1]
+	_ = x[PermWrite-2]
+	_ = x[PermExecute-4]
+	_ = x[PermSticky-8]
+	_ = x[PermSetUID-16]
+	_ = x[PermSetGID-32]
+}
+
+const (
+	_Perm_name_0 = "ReadWriteExecuteStickySetUIDSetGID"
+)
+
+var (
+	_Perm_index_0 = [...]uint8{0, 4, 9, 16, 22, 28, 34}
+)
+
+func (i Perm) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _Perm_name_0[_Perm_index_0[0]:_Perm_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Perm_name_0[_Perm_index_0[1]:_Perm_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Perm_name_0[_Perm_index_0[2]:_Perm_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _Perm_name_0[_Perm_index_0[3]:_Perm_index_0[4]])
+	}
+	if i&16 != 0 {
+		i, s = i&^16, append(s, _Perm_name_0[_Perm_index_0[4]:_Perm_index_0[5]])
+	}
+	if i&32 != 0 {
+		i, s = i&^32, append(s, _Perm_name_0[_Perm_index_0[5]:_Perm_index_0[6]])
+	}
+	if i != 0 {
+		s = append(s, "Perm("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Perm) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i Perm) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _Perm_name_0[_Perm_index_0[0]:_Perm_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Perm_name_0[_Perm_index_0[1]:_Perm_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Perm_name_0[_Perm_index_0[2]:_Perm_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _Perm_name_0[_Perm_index_0[3]:_Perm_index_0[4]]...)
+	}
+	if i&16 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^16, append(b, _Perm_name_0[_Perm_index_0[4]:_Perm_index_0[5]]...)
+	}
+	if i&32 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^32, append(b, _Perm_name_0[_Perm_index_0[5]:_Perm_index_0[6]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Perm("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Perm) String() string {
+	var buf [67]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ProtoNone-0]
+	_ = x[ProtoSYN-2]
+	_ = x[ProtoACK-4]
+	_ = x[ProtoFIN-8]
+	_ = x[ProtoRST-64]
+	_ = x[ProtoURG-128]
+}
+
+const (
+	_Proto_name_0 = "NoneSYNACKFIN"
+	_Proto_name_1 = "RSTURG"
+)
+
+var (
+	_Proto_index_0 = [...]uint8{0, 4, 7, 10, 13}
+	_Proto_index_1 = [...]uint8{0, 3, 6}
+)
+
+func (i Proto) AppendFlags(s []string) []string {
+	if i == 0 {
+		return append(s, _Proto_name_0[_Proto_index_0[0]:_Proto_index_0[1]])
+	}
+
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Proto_name_0[_Proto_index_0[1]:_Proto_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Proto_name_0[_Proto_index_0[2]:_Proto_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _Proto_name_0[_Proto_index_0[3]:_Proto_index_0[4]])
+	}
+	if i&64 != 0 {
+		i, s = i&^64, append(s, _Proto_name_1[_Proto_index_1[0]:_Proto_index_1[1]])
+	}
+	if i&128 != 0 {
+		i, s = i&^128, append(s, _Proto_name_1[_Proto_index_1[1]:_Proto_index_1[2]])
+	}
+	if i != 0 {
+		s = append(s, "Proto("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Proto) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i Proto) AppendText(b []byte) ([]byte, error) {
+	if i == 0 {
+		return append(b, _Proto_name_0[_Proto_index_0[0]:_Proto_index_0[1]]...), nil
+	}
+
+	n := len(b)
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Proto_name_0[_Proto_index_0[1]:_Proto_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Proto_name_0[_Proto_index_0[2]:_Proto_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _Proto_name_0[_Proto_index_0[3]:_Proto_index_0[4]]...)
+	}
+	if i&64 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^64, append(b, _Proto_name_1[_Proto_index_1[0]:_Proto_index_1[1]]...)
+	}
+	if i&128 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^128, append(b, _Proto_name_1[_Proto_index_1[1]:_Proto_index_1[2]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Proto("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+const _Proto_mask Proto = 0xce
+const _Proto_combo_name = "NoneSYNACKSYN+ACKFINSYN+FINACK+FINSYN+ACK+FINRSTSYN+RSTACK+RSTSYN+ACK+RSTFIN+RSTSYN+FIN+RSTACK+FIN+RSTSYN+ACK+FIN+RSTURGSYN+URGACK+URGSYN+ACK+URGFIN+URGSYN+FIN+URGACK+FIN+URGSYN+ACK+FIN+URGRST+URGSYN+RST+URGACK+RST+URGSYN+ACK+RST+URGFIN+RST+URGSYN+FIN+RST+URGACK+FIN+RST+URGSYN+ACK+FIN+RST+URG"
+
+var _Proto_combo_index = [...]uint16{0, 4, 7, 10, 17, 20, 27, 34, 45, 48, 55, 62, 73, 80, 91, 102, 117, 120, 127, 134, 145, 152, 163, 174, 189, 196, 207, 218, 233, 244, 259, 274, 293}
+
+func (i Proto) String() string {
+	if i&^_Proto_mask == 0 {
+		j := uint64(i)>>1&0x7 | uint64(i)>>6&0x3<<3
+		return _Proto_combo_name[_Proto_combo_index[j]:_Proto_combo_index[j+1]]
+	}
+
+	var buf [53]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
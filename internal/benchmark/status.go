@@ -0,0 +1,41 @@
+package benchmark
+
+//go:generate go run ../.. -output=status_string.go "-enums=Status=trimType;parse;text;json,MapStatus=trimType;layout:map"
+
+// Status is a sparse enum whose String method searches a sorted array.
+type Status int
+
+const (
+	StatusContinue     Status = 100
+	StatusOK           Status = 200
+	StatusCreated      Status = 201
+	StatusNoContent    Status = 204
+	StatusMoved        Status = 301
+	StatusNotModified  Status = 304
+	StatusBadRequest   Status = 400
+	StatusUnauthorized Status = 401
+	StatusForbidden    Status = 403
+	StatusNotFound     Status = 404
+	StatusConflict     Status = 409
+	StatusInternal     Status = 500
+	StatusUnavailable  Status = 503
+)
+
+// MapStatus is a sparse enum whose String method looks up a map.
+type MapStatus int
+
+const (
+	MapStatusContinue     MapStatus = 100
+	MapStatusOK           MapStatus = 200
+	MapStatusCreated      MapStatus = 201
+	MapStatusNoContent    MapStatus = 204
+	MapStatusMoved        MapStatus = 301
+	MapStatusNotModified  MapStatus = 304
+	MapStatusBadRequest   MapStatus = 400
+	MapStatusUnauthorized MapStatus = 401
+	MapStatusForbidden    MapStatus = 403
+	MapStatusNotFound     MapStatus = 404
+	MapStatusConflict     MapStatus = 409
+	MapStatusInternal     MapStatus = 500
+	MapStatusUnavailable  MapStatus = 503
+)
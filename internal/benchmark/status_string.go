@@ -0,0 +1,241 @@
+package benchmark
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[StatusContinue-100]
+	_ = x[StatusOK-200]
+	_ = x[StatusCreated-201]
+	_ = x[StatusNoContent-204]
+	_ = x[StatusMoved-301]
+	_ = x[StatusNotModified-304]
+	_ = x[StatusBadRequest-400]
+	_ = x[StatusUnauthorized-401]
+	_ = x[StatusForbidden-403]
+	_ = x[StatusNotFound-404]
+	_ = x[StatusConflict-409]
+	_ = x[StatusInternal-500]
+	_ = x[StatusUnavailable-503]
+}
+
+const _Status_name = "ContinueOKCreatedNoContentMovedNotModifiedBadRequestUnauthorizedForbiddenNotFoundConflictInternalUnavailable"
+
+var _Status_values = [...]Status{100, 200, 201, 204, 301, 304, 400, 401, 403, 404, 409, 500, 503}
+
+var _Status_index = [...]uint8{0, 8, 10, 17, 26, 31, 42, 52, 64, 73, 81, 89, 97, 108}
+
+func (i Status) String() string {
+	lo, hi := 0, len(_Status_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _Status_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_Status_values) && _Status_values[lo] == i {
+		return _Status_name[_Status_index[lo]:_Status_index[lo+1]]
+	}
+	return "Status(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+func _Status_lookup(s string) (Status, bool) {
+	switch len(s) {
+	case 2:
+		if s == _Status_name[8:10] {
+			return StatusOK, true
+		}
+	case 5:
+		if s == _Status_name[26:31] {
+			return StatusMoved, true
+		}
+	case 7:
+		if s == _Status_name[10:17] {
+			return StatusCreated, true
+		}
+	case 8:
+		switch s[3] {
+		case 'F':
+			if s == _Status_name[73:81] {
+				return StatusNotFound, true
+			}
+		case 'e':
+			if s == _Status_name[89:97] {
+				return StatusInternal, true
+			}
+		case 'f':
+			if s == _Status_name[81:89] {
+				return StatusConflict, true
+			}
+		case 't':
+			if s == _Status_name[0:8] {
+				return StatusContinue, true
+			}
+		}
+	case 9:
+		switch s[0] {
+		case 'F':
+			if s == _Status_name[64:73] {
+				return StatusForbidden, true
+			}
+		case 'N':
+			if s == _Status_name[17:26] {
+				return StatusNoContent, true
+			}
+		}
+	case 10:
+		if s == _Status_name[42:52] {
+			return StatusBadRequest, true
+		}
+	case 11:
+		switch s[0] {
+		case 'N':
+			if s == _Status_name[31:42] {
+				return StatusNotModified, true
+			}
+		case 'U':
+			if s == _Status_name[97:108] {
+				return StatusUnavailable, true
+			}
+		}
+	case 12:
+		if s == _Status_name[52:64] {
+			return StatusUnauthorized, true
+		}
+	}
+	return 0, false
+}
+
+var _Status_names = [...]string{
+	_Status_name[0:8],
+	_Status_name[8:10],
+	_Status_name[10:17],
+	_Status_name[17:26],
+	_Status_name[26:31],
+	_Status_name[31:42],
+	_Status_name[42:52],
+	_Status_name[52:64],
+	_Status_name[64:73],
+	_Status_name[73:81],
+	_Status_name[81:89],
+	_Status_name[89:97],
+	_Status_name[97:108],
+}
+
+// ParseStatus returns the Status named s.
+func ParseStatus(s string) (Status, error) {
+	if i, ok := _Status_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "Status", Input: s, Valid: append([]string(nil), _Status_names[:]...), Err: enum.ErrUnknownName}
+}
+
+// _Status_ordinal returns the position of i among the values of Status, or -1.
+func _Status_ordinal(i Status) int {
+	lo, hi := 0, len(_Status_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _Status_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_Status_values) && _Status_values[lo] == i {
+		return lo
+	}
+	return -1
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i Status) MarshalText() ([]byte, error) {
+	if _Status_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "Status", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *Status) UnmarshalText(b []byte) error {
+	v, err := ParseStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i Status) MarshalJSON() ([]byte, error) {
+	if _Status_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "Status", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Status) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseStatus(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MapStatusContinue-100]
+	_ = x[MapStatusOK-200]
+	_ = x[MapStatusCreated-201]
+	_ = x[MapStatusNoContent-204]
+	_ = x[MapStatusMoved-301]
+	_ = x[MapStatusNotModified-304]
+	_ = x[MapStatusBadRequest-400]
+	_ = x[MapStatusUnauthorized-401]
+	_ = x[MapStatusForbidden-403]
+	_ = x[MapStatusNotFound-404]
+	_ = x[MapStatusConflict-409]
+	_ = x[MapStatusInternal-500]
+	_ = x[MapStatusUnavailable-503]
+}
+
+const _MapStatus_name = "ContinueOKCreatedNoContentMovedNotModifiedBadRequestUnauthorizedForbiddenNotFoundConflictInternalUnavailable"
+
+var _MapStatus_map = map[MapStatus]string{
+	100: _MapStatus_name[0:8],
+	200: _MapStatus_name[8:10],
+	201: _MapStatus_name[10:17],
+	204: _MapStatus_name[17:26],
+	301: _MapStatus_name[26:31],
+	304: _MapStatus_name[31:42],
+	400: _MapStatus_name[42:52],
+	401: _MapStatus_name[52:64],
+	403: _MapStatus_name[64:73],
+	404: _MapStatus_name[73:81],
+	409: _MapStatus_name[81:89],
+	500: _MapStatus_name[89:97],
+	503: _MapStatus_name[97:108],
+}
+
+func (i MapStatus) String() string {
+	if str, ok := _MapStatus_map[i]; ok {
+		return str
+	}
+	return "MapStatus(" + strconv.FormatInt(int64(i), 10) + ")"
+}
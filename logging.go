@@ -0,0 +1,81 @@
+package main
+
+// buildLogValue generates the LogValue method implementing slog.LogValuer.
+// Enum values log as their name, flags as a group of the names of the flags
+// set and the number, signed if the type is.
+func (g *Generator) buildLogValue(typeName string, kind Kind, runs [][]Value) {
+	name := g.method(typeName, "LogValue")
+	if name == "" {
+		return
+	}
+	g.use("log/slog")
+	g.Printf("\n// %s implements slog.LogValuer.\n", name)
+	g.Printf("func (i %s) %s() slog.Value {\n", typeName, name)
+	if kind == Flag {
+		g.Printf("	return slog.GroupValue(\n")
+		g.Printf("		slog.Any(\"flags\", i.%s()),\n", g.callee(typeName, "ActiveFlags"))
+		if runs[0][0].signed {
+			g.Printf("		slog.Int64(\"value\", int64(i)),\n")
+		} else {
+			g.Printf("		slog.Uint64(\"value\", uint64(i)),\n")
+		}
+		g.Printf("	)\n")
+	} else {
+		g.Printf("	return slog.StringValue(i.%s())\n", g.callee(typeName, "String"))
+	}
+	g.Printf("}\n")
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: method name
+//	[3]: name of the String method
+//	[4]: integer type holding the number of a value
+//	[5]: verb printing the number along with the name
+//	[6]: name of the method printing i for the other verbs
+//	[7]: what the other verbs print
+const stringFormat = `
+// %[2]s implements fmt.Formatter: the verbs b, d, o, O, x and X print the
+// number of i, %%+v its name followed by the number in parentheses, and the
+// other verbs %[7]s.
+func (i %[1]s) %[2]s(f fmt.State, verb rune) {
+	switch verb {
+	case 'b', 'd', 'o', 'O', 'x', 'X':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), %[4]s(i))
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "%%s(%[5]s)", i.%[3]s(), %[4]s(i))
+			return
+		}
+		fallthrough
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), i.%[6]s())
+	}
+}
+`
+
+// buildFormat generates the Format method implementing fmt.Formatter, which
+// prints the numbers of values rather than formatting their names. Verbose
+// numbers are hexadecimal for flags. Error codes print their message as
+// they would without the method.
+func (g *Generator) buildFormat(typeName string, kind Kind, runs [][]Value, opts typeOptions) {
+	name := g.method(typeName, "Format")
+	if name == "" {
+		return
+	}
+	integer, verb := "uint64", "%d"
+	if runs[0][0].signed {
+		integer = "int64"
+	}
+	if kind == Flag {
+		verb = "%#x"
+	}
+	str := g.callee(typeName, "String")
+	other, what := str, "its name"
+	if opts.errorCode {
+		other, what = g.callee(typeName, "Error"), "its error message"
+	}
+	g.use("fmt")
+	g.Printf(stringFormat, typeName, name, str, integer, verb, other, what)
+}
@@ -72,46 +72,249 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 )
 
+// maxPrecompute is the largest number of flags whose combinations are
+// precomputed, yielding a table of 256 names.
+const maxPrecompute = 8
+
 type typeOptions struct {
 	kind        Kind
 	name        string
 	trimPrefix  string
+	transform   Transform
 	lineComment bool
 
-	getterSetter bool
+	getterSetter  bool
+	setOps        bool
+	parseFunc     bool // Generate the Parse function.
+	parseFold     bool // Parse matches names case-insensitively.
+	parsePrefix   bool // Parse matches names by unambiguous prefix.
+	textMarshal   bool // Generate MarshalText and UnmarshalText.
+	jsonMarshal   bool // Generate MarshalJSON and UnmarshalJSON.
+	description   bool // Generate Description from the comments of the constants.
+	deprecated    bool // Generate IsDeprecated.
+	values        bool // Generate the Values function.
+	allValues     bool // Include deprecated values in Values.
+	errorCode     bool // Generate the methods of the error interface.
+	logValue      bool // Generate LogValue.
+	formatter     bool // Generate Format.
+	set           bool // Generate the TSet type.
+	enumMap       bool // Generate the TMap type.
+	navigation    bool // Generate TFirst, TLast, Next, Prev and Ordinal.
+	lenient       bool // Parse unknown names as the value marked stringer:default.
+	stringDefault bool // String names unknown values after the default value.
+	precompute    int  // Maximum number of flags to precompute the String table for.
+	layout        Layout
+	optimize      Optimize
+	conflict      ConflictPolicy
+
+	// Naming templates for the getterSetter methods, with {} standing in
+	// for the flag name. An empty template leaves the method out.
+	getterName, setterName, clearName, toggleName string
+	pointerSetters                                bool // Setters modify the value in place.
+}
+
+// newTypeOptions returns the default options for the named type.
+func newTypeOptions(kind Kind, name string) *typeOptions {
+	return &typeOptions{
+		kind:       kind,
+		name:       name,
+		getterName: "{}",
+		setterName: "Set{}",
+		clearName:  "Clear{}",
+	}
 }
 
 func parseOption(kind Kind, inp string) (*typeOptions, error) {
 	name, options, _ := strings.Cut(inp, "=")
 
-	out := &typeOptions{
-		kind: kind,
-		name: name,
+	out := newTypeOptions(kind, name)
+
+	if err := out.parse(options); err != nil {
+		return nil, err
 	}
 
-	if options != "" {
-		for _, opt := range strings.Split(options, ";") {
-			k, v, _ := strings.Cut(opt, ":")
+	return out, nil
+}
+
+// parse applies the semicolon-separated list of options to o.
+func (o *typeOptions) parse(options string) error {
+	if options == "" {
+		return nil
+	}
 
+	for _, opt := range strings.Split(options, ";") {
+		k, v, _ := strings.Cut(opt, ":")
+
+		switch k {
+		case "lineComment":
+			o.lineComment = true
+		case "trimPrefix":
+			o.trimPrefix = v
+		case "trimType":
+			o.trimPrefix = o.name
+		case "transform":
+			transform, err := parseTransform(v)
+			if err != nil {
+				return err
+			}
+			o.transform = transform
+		case "getterSetter":
+			o.getterSetter = true
+		case "setOps":
+			o.setOps = true
+		case "parse":
+			o.parseFunc = true
+		case "parseFold":
+			o.parseFold = true
+		case "parsePrefix":
+			o.parsePrefix = true
+		case "text":
+			o.textMarshal = true
+		case "json":
+			o.jsonMarshal = true
+		case "description":
+			o.description = true
+		case "deprecated":
+			o.deprecated = true
+		case "error":
+			o.errorCode = true
+		case "slog":
+			o.logValue = true
+		case "format":
+			o.formatter = true
+		case "set":
+			o.set = true
+		case "arrayMap":
+			o.enumMap = true
+		case "navigation":
+			o.navigation = true
+		case "lenient":
+			o.lenient = true
+		case "stringDefault":
+			o.stringDefault = true
+		case "values":
+			switch v {
+			case "":
+				o.values, o.allValues = true, false
+			case "all":
+				o.values, o.allValues = true, true
+			default:
+				return fmt.Errorf("unknown values selection %q", v)
+			}
+		case "precompute":
+			o.precompute = maxPrecompute
+			if v != "" {
+				n, err := strconv.Atoi(v)
+				if err != nil || n < 0 || n > maxPrecompute {
+					return fmt.Errorf("precompute threshold %q is not a number between 0 and %d", v, maxPrecompute)
+				}
+				o.precompute = n
+			}
+		case "layout":
+			layout, err := parseLayout(v)
+			if err != nil {
+				return err
+			}
+			o.layout = layout
+		case "optimize":
+			optimize, err := parseOptimize(v)
+			if err != nil {
+				return err
+			}
+			o.optimize = optimize
+		case "conflict":
+			switch v {
+			case "error":
+				o.conflict = ConflictError
+			case "skip":
+				o.conflict = ConflictSkip
+			case "rename":
+				o.conflict = ConflictRename
+			default:
+				return fmt.Errorf("unknown conflict policy %q", v)
+			}
+		case "getter", "setter", "clear", "toggle":
+			if v != "" && !strings.Contains(v, "{}") {
+				return fmt.Errorf("%s name %q does not contain {}", k, v)
+			}
 			switch k {
-			case "lineComment":
-				out.lineComment = true
-			case "trimPrefix":
-				out.trimPrefix = v
-			case "trimType":
-				out.trimPrefix = name
-			case "getterSetter":
-				out.getterSetter = true
+			case "getter":
+				o.getterName = v
+			case "setter":
+				o.setterName = v
+			case "clear":
+				o.clearName = v
+			case "toggle":
+				o.toggleName = v
+			}
+		case "receiver":
+			switch v {
+			case "value":
+				o.pointerSetters = false
+			case "pointer":
+				o.pointerSetters = true
 			default:
-				return nil, fmt.Errorf("unknown option %q", k)
+				return fmt.Errorf("unknown receiver style %q", v)
 			}
+		default:
+			return fmt.Errorf("unknown option %q", k)
 		}
 	}
 
-	return out, nil
+	if o.kind == Flag && (o.lenient || o.stringDefault) {
+		return fmt.Errorf("lenient and stringDefault are only supported for enums")
+	}
+	return nil
+}
+
+func parseLayout(s string) (Layout, error) {
+	switch s {
+	case "auto":
+		return LayoutAuto, nil
+	case "switch":
+		return LayoutSwitch, nil
+	case "search":
+		return LayoutSearch, nil
+	case "map":
+		return LayoutMap, nil
+	default:
+		return LayoutDefault, fmt.Errorf("unknown layout %q", s)
+	}
+}
+
+func parseTransform(s string) (Transform, error) {
+	switch s {
+	case "snake":
+		return TransformSnake, nil
+	case "kebab":
+		return TransformKebab, nil
+	case "lower":
+		return TransformLower, nil
+	case "upper":
+		return TransformUpper, nil
+	case "title":
+		return TransformTitle, nil
+	case "words":
+		return TransformWords, nil
+	default:
+		return TransformNone, fmt.Errorf("unknown transform %q", s)
+	}
+}
+
+func parseOptimize(s string) (Optimize, error) {
+	switch s {
+	case "speed":
+		return OptimizeSpeed, nil
+	case "size":
+		return OptimizeSize, nil
+	default:
+		return OptimizeDefault, fmt.Errorf("unknown optimization %q", s)
+	}
 }
 
 func processTypeOptions(opts []typeOptions, kind Kind, inp string) ([]typeOptions, error) {
@@ -131,6 +334,19 @@ func processTypeOptions(opts []typeOptions, kind Kind, inp string) ([]typeOption
 	return opts, nil
 }
 
+// applyDefaults gives the layout and optimization set by flags to the types
+// leaving them unset.
+func applyDefaults(types []typeOptions, layout Layout, optimization Optimize) {
+	for i := range types {
+		if types[i].layout == LayoutDefault {
+			types[i].layout = layout
+		}
+		if types[i].optimize == OptimizeDefault {
+			types[i].optimize = optimization
+		}
+	}
+}
+
 // Usage is a replacement usage function for the flags package.
 func Usage() {
 	_, _ = fmt.Fprintf(os.Stderr, `usage:
@@ -149,6 +365,12 @@ func run() (err error) {
 	var (
 		output    = flag.String("output", "", "output file name; default srcdir/<type>_string.go")
 		buildTags = flag.String("tags", "", "comma-separated list of build tags to apply")
+		layoutStr = flag.String("layout", "auto", "default layout of the name lookup: auto, switch, search or map")
+		optimize  = flag.String("optimize", "speed", "optimize the generated code for speed or size")
+		verbose   = flag.Bool("v", false, "report the size of the code generated for each type")
+		i18nDir   = flag.String("i18n", "", "directory of message catalogs translating the names of enum values")
+		dotFile   = flag.String("emit-dot", "", "file to write the state machines given by transitions to, in the DOT language")
+		mmdFile   = flag.String("emit-mermaid", "", "file to write the state machines given by transitions to, as a Mermaid diagram")
 
 		enumTypesStrFlag = flag.String("enums", "", "comma-separated list of enum types")
 		flagTypesStrFlag = flag.String("flags", "", "comma-separated list of flag types")
@@ -169,8 +391,6 @@ func run() (err error) {
 		return err
 	}
 
-	hasFlags := len(types) > 0
-
 	types, err = processTypeOptions(types, Enum, *enumTypesStrFlag)
 	if err != nil {
 		return err
@@ -181,6 +401,16 @@ func run() (err error) {
 		os.Exit(2)
 	}
 
+	layout, err := parseLayout(*layoutStr)
+	if err != nil {
+		return err
+	}
+	optimization, err := parseOptimize(*optimize)
+	if err != nil {
+		return err
+	}
+	applyDefaults(types, layout, optimization)
+
 	var tags []string
 	if len(*buildTags) > 0 {
 		tags = strings.Split(*buildTags, ",")
@@ -193,20 +423,48 @@ func run() (err error) {
 		args = []string{"."}
 	}
 
+	absOutputName, err := filepath.Abs(outputName)
+	if err != nil {
+		return err
+	}
+
 	// Parse the package once.
-	g := Generator{}
+	g := Generator{outputName: absOutputName}
 	if err := g.parsePackage(args, tags); err != nil {
 		log.Fatal(err)
 	}
 
-	// Print the header and package clause.
-	g.generateStart(hasFlags)
+	if *i18nDir != "" {
+		if g.catalogs, err = loadCatalogs(*i18nDir); err != nil {
+			return err
+		}
+	}
+
+	// Check the default values of all types before generating any code.
+	for _, typeOpt := range types {
+		findDefault(typeOpt.name, typeOpt.kind, g.collect(typeOpt), typeOpt)
+	}
+
 	for _, typeOpt := range types {
-		g.generate(typeOpt.name, typeOpt.kind, typeOpt.trimPrefix, typeOpt.lineComment, typeOpt.getterSetter)
+		start := g.buf.Len()
+		g.generate(typeOpt)
+		if *verbose {
+			log.Printf("%s: %d bytes of code", typeOpt.name, g.codeSize(start))
+		}
 	}
+	g.checkCatalogs()
 
 	// Format the output.
-	src := g.format()
+	src, err := g.format()
+	if err != nil {
+		return err
+	}
+
+	// Make sure the output compiles along with the package before replacing
+	// the previous output file.
+	if err := g.check(src, absOutputName); err != nil {
+		return fmt.Errorf("generated code does not compile:\n%w", err)
+	}
 
 	// Write to the given output file.
 	if err := os.WriteFile(outputName, src, 0644); err != nil {
@@ -215,6 +473,23 @@ func run() (err error) {
 
 	fmt.Fprintf(os.Stderr, "wrote output to %s\n", outputName)
 
+	// Without transitions, the diagrams would be empty, and previous ones
+	// are left alone.
+	if (*dotFile != "" || *mmdFile != "") && len(g.graphs) == 0 {
+		log.Printf("warning: no type has transitions, not writing diagrams")
+		return nil
+	}
+	if *dotFile != "" {
+		if err := os.WriteFile(*dotFile, dot(g.graphs), 0644); err != nil {
+			return fmt.Errorf("writing graph: %w", err)
+		}
+	}
+	if *mmdFile != "" {
+		if err := os.WriteFile(*mmdFile, mermaid(g.graphs), 0644); err != nil {
+			return fmt.Errorf("writing graph: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	types, err := processTypeOptions(nil, Enum, "A,B=optimize:speed;layout:auto,C=optimize:size;layout:map")
+	assert.NilError(t, err)
+	applyDefaults(types, LayoutSearch, OptimizeSize)
+
+	assert.Equal(t, types[0].optimize, OptimizeSize)
+	assert.Equal(t, types[0].layout, LayoutSearch)
+	assert.Equal(t, types[1].optimize, OptimizeSpeed)
+	assert.Equal(t, types[1].layout, LayoutAuto)
+	assert.Equal(t, types[2].optimize, OptimizeSize)
+	assert.Equal(t, types[2].layout, LayoutMap)
+}
+
+func TestParseOptions(t *testing.T) {
+	_, err := processTypeOptions(nil, Flag, "A=lenient")
+	assert.ErrorContains(t, err, "only supported for enums")
+	_, err = processTypeOptions(nil, Enum, "A=lenient;stringDefault")
+	assert.NilError(t, err)
+}
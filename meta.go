@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// metaValue is the value of a metadata key given by a stringer:meta
+// directive.
+type metaValue struct {
+	text   string
+	quoted bool // Quoted values are strings.
+}
+
+// applyMeta records the key=value fields of a stringer:meta directive.
+func (v *Value) applyMeta(args string) error {
+	fields, err := directiveFields(args)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if !token.IsIdentifier(f.key) {
+			return fmt.Errorf("stringer:meta takes key=value pairs: %q", f.value)
+		}
+		if v.meta == nil {
+			v.meta = make(map[string]metaValue)
+		}
+		v.meta[f.key] = metaValue{text: f.value, quoted: f.quoted}
+	}
+	return nil
+}
+
+// metaKey is a metadata key along with the Go type inferred from its values.
+type metaKey struct {
+	name  string
+	typ   string // string, int or bool.
+	mixed bool   // Whether values of different types are taken as strings.
+}
+
+// metaType returns the type of a metadata value: bool or int if it is
+// unquoted and parses as such, and string otherwise.
+func metaType(m metaValue) string {
+	switch {
+	case m.quoted:
+		return "string"
+	case m.text == "true" || m.text == "false":
+		return "bool"
+	}
+	if _, err := strconv.ParseInt(m.text, 0, 64); err == nil {
+		return "int"
+	}
+	return "string"
+}
+
+// metaKeys returns the metadata keys given to the values of runs, in
+// alphabetical order. The type of a key is that of its values, or string if
+// they differ.
+func metaKeys(runs [][]Value) []metaKey {
+	index := make(map[string]int)
+	var keys []metaKey
+	for _, values := range runs {
+		for _, v := range values {
+			for name, m := range v.meta {
+				typ := metaType(m)
+				i, ok := index[name]
+				if !ok {
+					index[name] = len(keys)
+					keys = append(keys, metaKey{name: name, typ: typ})
+					continue
+				}
+				if keys[i].typ != typ {
+					keys[i].typ, keys[i].mixed = "string", true
+				}
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].name < keys[j].name })
+	return keys
+}
+
+// initialisms are the words written in upper case in exported names.
+var initialisms = map[string]bool{
+	"API": true, "ASCII": true, "CPU": true, "CSS": true, "DNS": true,
+	"EOF": true, "GUID": true, "HTML": true, "HTTP": true, "HTTPS": true,
+	"ID": true, "IP": true, "JSON": true, "RPC": true, "SQL": true,
+	"TCP": true, "TLS": true, "TTL": true, "UDP": true, "UI": true,
+	"URI": true, "URL": true, "UTF8": true, "UUID": true, "XML": true,
+}
+
+// exportedName returns the name of the method returning the metadata key,
+// such as HTTPStatus for httpStatus.
+func exportedName(key string) string {
+	var b strings.Builder
+	for _, w := range splitWords(key) {
+		if upper := strings.ToUpper(w); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(w)
+		b.WriteRune(unicode.ToUpper(r))
+		b.WriteString(w[n:])
+	}
+	return b.String()
+}
+
+// generatedMethods are the methods stringer may generate itself, which
+// metadata keys must not be named after.
+var generatedMethods = map[string]bool{
+	"ActiveFlags": true, "AppendFlags": true, "AppendText": true,
+	"CanTransitionTo": true, "Count": true, "Description": true,
+	"DisplayName": true, "Error": true, "Format": true, "Has": true,
+	"HasAll": true, "HasAny": true, "Intersect": true,
+	"IsDeprecated": true, "IsTerminal": true, "IsZero": true,
+	"JSONName": true, "Known": true, "Localized": true, "LogValue": true,
+	"MarshalJSON": true, "MarshalText": true, "Next": true, "Ordinal": true,
+	"Prev": true, "String": true, "TextName": true, "Toggle": true,
+	"Transitions": true, "Union": true, "Unknown": true,
+	"UnmarshalJSON": true, "UnmarshalText": true, "Without": true,
+}
+
+// buildMeta generates the methods returning the metadata given to the values
+// by stringer:meta directives. Values without a key have its zero value.
+func (g *Generator) buildMeta(typeName string, kind Kind, layout Layout, runs [][]Value) {
+	keys := metaKeys(runs)
+	if len(keys) == 0 {
+		return
+	}
+	g.declareOrdinal(typeName, kind, runs, layout)
+
+	for _, key := range keys {
+		if key.mixed {
+			log.Printf("warning: type %s: values of %s have different types and are taken as strings", typeName, key.name)
+		}
+		if name := exportedName(key.name); generatedMethods[name] {
+			log.Fatalf("type %s: metadata key %s would be returned by %s, which stringer generates itself", typeName, key.name, name)
+		}
+		name := g.method(typeName, exportedName(key.name))
+		if name == "" {
+			continue
+		}
+
+		result := fmt.Sprintf("_%s_meta_%s[j]", typeName, key.name)
+		switch key.typ {
+		case "string":
+			g.declareValueTable(typeName, "meta_"+key.name, "meta_"+key.name+"_index", runs, func(v *Value) string {
+				return v.meta[key.name].text
+			})
+			result = fmt.Sprintf("_%[1]s_meta_%[2]s[_%[1]s_meta_%[2]s_index[j]:_%[1]s_meta_%[2]s_index[j+1]]", typeName, key.name)
+		default:
+			var elems []string
+			for _, values := range runs {
+				for _, v := range values {
+					m, ok := v.meta[key.name]
+					switch {
+					case ok:
+						elems = append(elems, m.text)
+					case key.typ == "bool":
+						elems = append(elems, "false")
+					default:
+						elems = append(elems, "0")
+					}
+				}
+			}
+			g.Printf("\nvar _%s_meta_%s = [...]%s{%s}\n", typeName, key.name, key.typ, strings.Join(elems, ", "))
+		}
+
+		zero := map[string]string{"string": `""`, "int": "0", "bool": "false"}[key.typ]
+		g.Printf("\n// %s returns the %s metadata of i, or %s for values without it.\n", name, key.name, zero)
+		g.Printf("func (i %s) %s() %s {\n", typeName, name, key.typ)
+		g.Printf("	if j := _%s_ordinal(i); j >= 0 {\n", typeName)
+		g.Printf("		return %s\n", result)
+		g.Printf("	}\n")
+		g.Printf("	return %s\n", zero)
+		g.Printf("}\n")
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMetaKeys(t *testing.T) {
+	assert.Equal(t, exportedName("httpStatus"), "HTTPStatus")
+	assert.Equal(t, exportedName("retryable"), "Retryable")
+	assert.Equal(t, exportedName("user_id"), "UserID")
+	assert.Assert(t, generatedMethods[exportedName("description")])
+
+	runs := [][]Value{{
+		{meta: map[string]metaValue{"a": {text: "1"}, "b": {text: "true"}, "c": {text: "1"}}},
+		{meta: map[string]metaValue{"a": {text: "2"}, "b": {text: "1", quoted: true}, "c": {text: "x"}}},
+	}}
+	keys := metaKeys(runs)
+	assert.Equal(t, len(keys), 3)
+	assert.Equal(t, keys[0], metaKey{name: "a", typ: "int"})
+	assert.Equal(t, keys[1], metaKey{name: "b", typ: "string", mixed: true})
+	assert.Equal(t, keys[2], metaKey{name: "c", typ: "string", mixed: true})
+}
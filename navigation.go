@@ -0,0 +1,58 @@
+package main
+
+import "log"
+
+// buildNavigation generates the constants holding the first and last values
+// of an enum, and the methods stepping through its values in increasing
+// order, skipping the gaps between runs.
+func (g *Generator) buildNavigation(typeName string, kind Kind, layout Layout, runs [][]Value) {
+	if kind == Flag {
+		log.Fatalf("type %s: navigation is only supported for enums", typeName)
+	}
+	first, last := &runs[0][0], &runs[len(runs)-1][len(runs[len(runs)-1])-1]
+
+	firstName, lastName := g.ident(typeName+"First"), g.ident(typeName+"Last")
+	if firstName != "" || lastName != "" {
+		g.Printf("\nconst (\n")
+		if firstName != "" {
+			g.Printf("	%s %s = %s // The smallest value of %s.\n", firstName, typeName, first.originalName, typeName)
+		}
+		if lastName != "" {
+			g.Printf("	%s %s = %s // The largest value of %s.\n", lastName, typeName, last.originalName, typeName)
+		}
+		g.Printf(")\n")
+	}
+
+	g.declareOrdinal(typeName, kind, runs, layout)
+	if name := g.method(typeName, "Ordinal"); name != "" {
+		g.Printf("\n// %s returns the position of i among the values of %s, starting at 0,\n", name, typeName)
+		g.Printf("// or -1 if i is not one of them.\n")
+		g.Printf("func (i %s) %s() int {\n", typeName, name)
+		g.Printf("	return _%s_ordinal(i)\n", typeName)
+		g.Printf("}\n")
+	}
+
+	g.declareValues(typeName, runs)
+	if name := g.method(typeName, "Next"); name != "" {
+		g.Printf("\n// %s returns the value following i, or i and false if i is the last\n", name)
+		g.Printf("// value of %s or not one of them.\n", typeName)
+		g.Printf("func (i %s) %s() (%s, bool) {\n", typeName, name, typeName)
+		g.Printf("	j := _%s_ordinal(i)\n", typeName)
+		g.Printf("	if j < 0 || j+1 == len(_%s_values) {\n", typeName)
+		g.Printf("		return i, false\n")
+		g.Printf("	}\n")
+		g.Printf("	return _%s_values[j+1], true\n", typeName)
+		g.Printf("}\n")
+	}
+	if name := g.method(typeName, "Prev"); name != "" {
+		g.Printf("\n// %s returns the value preceding i, or i and false if i is the first\n", name)
+		g.Printf("// value of %s or not one of them.\n", typeName)
+		g.Printf("func (i %s) %s() (%s, bool) {\n", typeName, name, typeName)
+		g.Printf("	j := _%s_ordinal(i)\n", typeName)
+		g.Printf("	if j <= 0 {\n")
+		g.Printf("		return i, false\n")
+		g.Printf("	}\n")
+		g.Printf("	return _%s_values[j-1], true\n", typeName)
+		g.Printf("}\n")
+	}
+}
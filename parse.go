@@ -0,0 +1,393 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// enumPackage is the import path of the package holding the run time support
+// of the generated code.
+const enumPackage = "github.com/0x5a17ed/stringer/enum"
+
+// parseName is a value along with the expression naming it in the generated
+// code, which is a string literal for aliases.
+type parseName struct {
+	value *Value
+	name  string
+	expr  string
+	alias bool
+}
+
+// parseNames returns the values of runs along with the expressions slicing
+// their names out of the tables declared for the layout, so the names are
+// not stored twice, followed by their aliases. perRun tells whether each run
+// has its own table.
+func parseNames(typeName string, runs [][]Value, perRun bool) []parseName {
+	var names []parseName
+	n := 0
+	for i, values := range runs {
+		table := fmt.Sprintf("_%s_name", typeName)
+		if perRun {
+			table, n = fmt.Sprintf("_%s_name_%d", typeName, i), 0
+		}
+		for j := range values {
+			expr := table
+			if !perRun || len(values) > 1 {
+				expr = fmt.Sprintf("%s[%d:%d]", table, n, n+len(values[j].name))
+			}
+			names = append(names, parseName{value: &values[j], name: values[j].name, expr: expr})
+			n += len(values[j].name)
+		}
+	}
+	for _, values := range runs {
+		for j := range values {
+			for _, alias := range values[j].aliases {
+				names = append(names, parseName{value: &values[j], name: alias, expr: strconv.Quote(alias), alias: true})
+			}
+		}
+	}
+	return names
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: function name
+//	[3]: doc comment
+//	[4]: statement returning unknown names (stringParse only)
+const (
+	stringParse = `
+%[3]sfunc %[2]s(s string) (%[1]s, error) {
+	if i, ok := _%[1]s_lookup(s); ok {
+		return i, nil
+	}
+	%[4]s
+}
+`
+	stringParseFlags = `
+%[3]sfunc %[2]s(s string) (%[1]s, error) {
+	var i %[1]s
+	for s != "" {
+		name, rest, _ := strings.Cut(s, "+")
+		f, ok := _%[1]s_lookup(name)
+		if !ok {
+			return 0, &enum.ParseError{Type: "%[1]s", Input: name, Valid: append([]string(nil), _%[1]s_names[:]...), Err: enum.ErrUnknownName}
+		}
+		i, s = i|f, rest
+	}
+	return i, nil
+}
+`
+	stringParseFlagsMatch = `
+%[3]sfunc %[2]s(s string) (%[1]s, error) {
+	var i %[1]s
+	for s != "" {
+		name, rest, _ := strings.Cut(s, "+")
+		f, err := _%[1]s_match(name)
+		if err != nil {
+			return 0, err
+		}
+		i, s = i|f, rest
+	}
+	return i, nil
+}
+`
+)
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: expression telling whether s abbreviates name (stringMatchPrefix only)
+const (
+	stringMatchFold = `
+	for j, name := range _%[1]s_names {
+		if strings.EqualFold(s, name) {
+			return _%[1]s_values[j], nil
+		}
+	}
+`
+	stringMatchFoldAliases = `
+	for _, alias := range _%[1]s_aliases {
+		if strings.EqualFold(s, alias.name) {
+			return alias.value, nil
+		}
+	}
+`
+	stringMatchPrefix = `
+	if s != "" {
+		var i %[1]s
+		var candidates []string
+		for j, name := range _%[1]s_names {
+			if len(s) < len(name) && %[2]s {
+				i, candidates = _%[1]s_values[j], append(candidates, name)
+			}
+		}
+		if len(candidates) == 1 {
+			return i, nil
+		}
+		if len(candidates) > 1 {
+			return 0, &enum.ParseError{
+				Type:       "%[1]s",
+				Input:      s,
+				Valid:      append([]string(nil), _%[1]s_names[:]...),
+				Candidates: candidates,
+				Err:        enum.ErrAmbiguousName,
+			}
+		}
+	}
+`
+)
+
+// buildParse generates the Parse function of the named type, inverting the
+// names looked up in the given layout. The value named by a string is found
+// by switching on its length and then on the bytes telling the names of
+// that length apart. When optimizing for size, a loop over the name table
+// replaces the switch wherever the layout provides a single table.
+func (g *Generator) buildParse(typeName string, kind Kind, layout Layout, runs [][]Value, opts typeOptions) {
+	perRun := layout == LayoutSwitch && (kind == Flag || len(runs) > 1)
+	names := parseNames(typeName, runs, perRun)
+	g.checkParseNames(typeName, names, opts)
+	var aliases []parseName
+	for _, n := range names {
+		if n.alias {
+			aliases = append(aliases, n)
+		}
+	}
+
+	g.Printf("\n")
+	if opts.optimize == OptimizeSize && layout == LayoutSearch {
+		g.declareValues(typeName, runs)
+	}
+	g.Printf("func _%[1]s_lookup(s string) (%[1]s, bool) {\n", typeName)
+	switch {
+	case opts.optimize != OptimizeSize || perRun:
+		g.lookupSwitch(names, "true")
+	case layout == LayoutSearch:
+		g.Printf("for j, v := range _%s_values {\n", typeName)
+		g.Printf("	if s == _%[1]s_name[_%[1]s_index[j]:_%[1]s_index[j+1]] {\n", typeName)
+		g.Printf("		return v, true\n")
+		g.Printf("	}\n")
+		g.Printf("}\n")
+	case layout == LayoutMap:
+		g.Printf("for k, v := range _%s_map {\n", typeName)
+		g.Printf("	if s == v {\n")
+		g.Printf("		return k, true\n")
+		g.Printf("	}\n")
+		g.Printf("}\n")
+	default:
+		value := fmt.Sprintf("%s(j)", typeName)
+		if first := &runs[0][0]; first.value != 0 {
+			value += " + " + first.String()
+		}
+		g.Printf("for j := 0; j < len(_%s_index)-1; j++ {\n", typeName)
+		g.Printf("	if s == _%[1]s_name[_%[1]s_index[j]:_%[1]s_index[j+1]] {\n", typeName)
+		g.Printf("		return %s, true\n", value)
+		g.Printf("	}\n")
+		g.Printf("}\n")
+	}
+	if opts.optimize == OptimizeSize && !perRun {
+		// The table holds no aliases, so they are switched on after it.
+		if len(aliases) > 0 {
+			g.lookupSwitch(aliases, "true")
+		}
+	}
+	g.Printf("return 0, false\n")
+	g.Printf("}\n")
+
+	// The marshalers and the types built on the parse function call a
+	// hand-written one left in its place.
+	fn := g.ident("Parse" + typeName)
+
+	// The names are listed by parse errors, and matching names other than
+	// exactly loops over them. Lenient parse functions return no errors
+	// listing them, unlike the unmarshalers of lenient sets and maps.
+	if fn != "" && (!g.lenient || opts.parseFold || opts.parsePrefix) || g.lenient && (opts.set || opts.enumMap) {
+		g.Printf("\nvar _%s_names = [...]string{\n", typeName)
+		for _, n := range names {
+			if !n.alias {
+				g.Printf("\t%s,\n", n.expr)
+			}
+		}
+		g.Printf("}\n")
+	}
+
+	if fn == "" {
+		g.parse = "Parse" + typeName
+		return
+	}
+	g.parse = fn
+
+	// Values parsed from deprecated names are reported by a wrapper.
+	doc := fmt.Sprintf("// %s returns the %s named s.\n", fn, typeName)
+	if kind == Flag {
+		doc = fmt.Sprintf("// %s returns the %s made of the flags named in s, separated by\n// '+'. The empty string stands for no flags.\n", fn, typeName)
+	}
+	if g.lenient {
+		doc += fmt.Sprintf("// Unknown names stand for %s.\n", g.fallback.originalName)
+	}
+	if hasDeprecated(runs) {
+		inner := fmt.Sprintf("_%s_parse", typeName)
+		defer g.buildParseDeprecated(typeName, kind, runs, fn, inner, doc)
+		fn, doc = inner, ""
+	}
+
+	if !opts.parseFold && !opts.parsePrefix {
+		if kind == Flag {
+			g.use("strings")
+			g.use(enumPackage)
+			g.Printf(stringParseFlags, typeName, fn, doc)
+		} else {
+			g.Printf(stringParse, typeName, fn, doc, g.unknownParse(typeName, fmt.Sprintf("_%s_names", typeName)))
+		}
+		return
+	}
+
+	g.use("strings")
+	g.declareValues(typeName, runs)
+	if opts.parseFold && len(aliases) > 0 {
+		g.Printf("\nvar _%s_aliases = [...]struct {\n", typeName)
+		g.Printf("	name  string\n")
+		g.Printf("	value %s\n", typeName)
+		g.Printf("}{\n")
+		for _, n := range aliases {
+			g.Printf("	{%s, %s},\n", n.expr, n.value.originalName)
+		}
+		g.Printf("}\n")
+	}
+	g.Printf("\n")
+
+	if kind == Flag {
+		g.Printf("func _%[1]s_match(s string) (%[1]s, error) {\n", typeName)
+	} else {
+		g.Printf("%sfunc %s(s string) (%s, error) {\n", doc, fn, typeName)
+	}
+	g.Printf("	if i, ok := _%s_lookup(s); ok {\n", typeName)
+	g.Printf("		return i, nil\n")
+	g.Printf("	}\n")
+	if opts.parseFold {
+		g.Printf(stringMatchFold, typeName)
+		if len(aliases) > 0 {
+			g.Printf(stringMatchFoldAliases, typeName)
+		}
+	}
+	if opts.parsePrefix {
+		match := "s == name[:len(s)]"
+		if opts.parseFold {
+			match = "strings.EqualFold(s, name[:len(s)])"
+		}
+		g.use(enumPackage)
+		g.Printf(stringMatchPrefix, typeName, match)
+	}
+	g.Printf("	%s\n", g.unknownParse(typeName, fmt.Sprintf("_%s_names", typeName)))
+	g.Printf("}\n")
+
+	if kind == Flag {
+		g.Printf(stringParseFlagsMatch, typeName, fn, doc)
+	}
+}
+
+// checkParseNames makes sure every value can be told apart by its name when
+// parsing. Names of different values differing only in case are an error
+// when matching case insensitively, aliases included. A name that is a
+// prefix of another one can only be given in full when matching by prefix,
+// which is worth a warning.
+func (g *Generator) checkParseNames(typeName string, names []parseName, opts typeOptions) {
+	equal, hasPrefix := func(a, b string) bool { return a == b }, strings.HasPrefix
+	if opts.parseFold {
+		equal = strings.EqualFold
+		hasPrefix = func(s, prefix string) bool {
+			return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+		}
+	}
+
+	for i, a := range names {
+		for _, b := range names[:i] {
+			x, y := b.value, a.value
+			switch {
+			case a.name == b.name || x != y && equal(a.name, b.name):
+				log.Fatalf("type %s: %s and %s are both named %q", typeName, x.originalName, y.originalName, a.name)
+			case !opts.parsePrefix || a.alias || b.alias:
+			case hasPrefix(a.name, b.name):
+				log.Printf("warning: type %s: %q is a prefix of %q, so %s can't be abbreviated", typeName, b.name, a.name, x.originalName)
+			case hasPrefix(b.name, a.name):
+				log.Printf("warning: type %s: %q is a prefix of %q, so %s can't be abbreviated", typeName, a.name, b.name, y.originalName)
+			}
+		}
+	}
+}
+
+// lookupSwitch generates the switch on the length of s finding the value
+// among names, returning it along with ok.
+func (g *Generator) lookupSwitch(names []parseName, ok string) {
+	byLen := make(map[int][]parseName)
+	var lengths []int
+	for _, n := range names {
+		l := len(n.name)
+		if byLen[l] == nil {
+			lengths = append(lengths, l)
+		}
+		byLen[l] = append(byLen[l], n)
+	}
+	sort.Ints(lengths)
+
+	g.Printf("switch len(s) {\n")
+	for _, l := range lengths {
+		g.Printf("case %d:\n", l)
+		g.lookupBytes(byLen[l], ok)
+	}
+	g.Printf("}\n")
+}
+
+// lookupBytes generates the code finding the value among names of the same
+// length. It switches on the byte position telling most names apart until a
+// single name is left to compare s with.
+func (g *Generator) lookupBytes(names []parseName, ok string) {
+	if len(names) == 1 {
+		g.Printf("if s == %s {\n", names[0].expr)
+		g.Printf("	return %s, %s\n", names[0].value.originalName, ok)
+		g.Printf("}\n")
+		return
+	}
+
+	pos, most := 0, 0
+	for p := 0; p < len(names[0].name); p++ {
+		distinct := make(map[byte]bool)
+		for _, n := range names {
+			distinct[n.name[p]] = true
+		}
+		if len(distinct) > most {
+			pos, most = p, len(distinct)
+		}
+	}
+
+	groups := make(map[byte][]parseName)
+	var keys []byte
+	for _, n := range names {
+		b := n.name[pos]
+		if groups[b] == nil {
+			keys = append(keys, b)
+		}
+		groups[b] = append(groups[b], n)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	g.Printf("switch s[%d] {\n", pos)
+	for _, b := range keys {
+		g.Printf("case %s:\n", byteLiteral(b))
+		g.lookupBytes(groups[b], ok)
+	}
+	g.Printf("}\n")
+}
+
+// byteLiteral returns the Go literal for b, a character literal if b is
+// ASCII.
+func byteLiteral(b byte) string {
+	if b < utf8.RuneSelf {
+		return strconv.QuoteRuneToASCII(rune(b))
+	}
+	return fmt.Sprintf("%#x", b)
+}
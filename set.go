@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: number of words of the bitset
+//	[3]: name of the String method
+//	[4]: statements setting v to the value named by name
+//	[5]: name of the set type
+//	[6]: name of the function returning a new set (stringNewSet only)
+const (
+	stringSetType = `
+// %[5]s is a set of %[1]s values, holding one bit per value.
+type %[5]s struct {
+	bits [%[2]d]uint64
+}
+`
+	stringNewSet = `
+// %[6]s returns the set of the given values.
+func %[6]s(values ...%[1]s) %[5]s {
+	var s %[5]s
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+`
+	stringSetMethods = `
+// Add adds v to s. Values not defined by %[1]s are ignored.
+func (s *%[5]s) Add(v %[1]s) {
+	if j := _%[1]s_ordinal(v); j >= 0 {
+		s.bits[j/64] |= 1 << (j %% 64)
+	}
+}
+
+// Remove removes v from s.
+func (s *%[5]s) Remove(v %[1]s) {
+	if j := _%[1]s_ordinal(v); j >= 0 {
+		s.bits[j/64] &^= 1 << (j %% 64)
+	}
+}
+
+// Contains reports whether v is in s.
+func (s %[5]s) Contains(v %[1]s) bool {
+	j := _%[1]s_ordinal(v)
+	return j >= 0 && s.bits[j/64]&(1<<(j%%64)) != 0
+}
+
+// Len returns the number of values in s.
+func (s %[5]s) Len() int {
+	n := 0
+	for _, w := range s.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All calls yield with the values in s, in increasing order, until it
+// returns false. With Go 1.23 or later, range over s.All to do so.
+func (s %[5]s) All(yield func(%[1]s) bool) {
+	for k, w := range s.bits {
+		for ; w != 0; w &= w - 1 {
+			if !yield(_%[1]s_values[k*64+bits.TrailingZeros64(w)]) {
+				return
+			}
+		}
+	}
+}
+
+// names returns the names of the values in s.
+func (s %[5]s) names() []string {
+	var names []string
+	s.All(func(v %[1]s) bool {
+		names = append(names, v.%[3]s())
+		return true
+	})
+	return names
+}
+
+// String returns the names of the values in s, such as [A B].
+func (s %[5]s) String() string {
+	return "[" + strings.Join(s.names(), " ") + "]"
+}
+
+// MarshalText implements encoding.TextMarshaler, separating names by commas.
+func (s %[5]s) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(s.names(), ",")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *%[5]s) UnmarshalText(b []byte) error {
+	var t %[5]s
+	for _, name := range strings.Split(string(b), ",") {
+		if name == "" {
+			continue
+		}
+%[4]s		t.Add(v)
+	}
+	*s = t
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding s as an array of names.
+func (s %[5]s) MarshalJSON() ([]byte, error) {
+	names := s.names()
+	if names == nil {
+		names = []string{}
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *%[5]s) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	var t %[5]s
+	for _, name := range names {
+%[4]s		t.Add(v)
+	}
+	*s = t
+	return nil
+}
+`
+)
+
+// buildSet generates the set type of an enum, a bitset indexed by the
+// positions of the values. Its names are those of String, parsed by Parse
+// unless it is lenient.
+// The generated code builds with Go versions before range over functions.
+func (g *Generator) buildSet(typeName string, kind Kind, layout Layout, runs [][]Value) {
+	if kind == Flag {
+		log.Fatalf("type %s: sets are only supported for enums", typeName)
+	}
+
+	// The methods belong to the set type, so a hand-written one takes the
+	// place of all of them.
+	setName := g.ident(typeName + "Set")
+	if setName == "" {
+		return
+	}
+	n := 0
+	for _, values := range runs {
+		n += len(values)
+	}
+
+	g.declareOrdinal(typeName, kind, runs, layout)
+	g.declareValues(typeName, runs)
+
+	// Lenient parse functions take unknown names for the default value,
+	// which would add it to the set in their place.
+	value := fmt.Sprintf("\t\tv, err := %s(name)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n", g.parse)
+	if g.lenient {
+		g.use(enumPackage)
+		value = fmt.Sprintf("\t\tv, ok := _%[1]s_lookup(name)\n\t\tif !ok {\n\t\t\treturn &enum.ParseError{Type: %[1]q, Input: name, Valid: append([]string(nil), _%[1]s_names[:]...), Err: enum.ErrUnknownName}\n\t\t}\n", typeName)
+	}
+
+	g.use("encoding/json")
+	g.use("math/bits")
+	g.use("strings")
+	newName := g.ident("New" + typeName + "Set")
+	args := []any{typeName, (n + 63) / 64, g.callee(typeName, "String"), value, setName, newName}
+	g.Printf(stringSetType, args...)
+	if newName != "" {
+		g.Printf(stringNewSet, args...)
+	}
+	g.Printf(stringSetMethods, args...)
+}
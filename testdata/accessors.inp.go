@@ -0,0 +1,9 @@
+package test
+
+type Accessors uint
+
+const (
+	AccessorsNone Accessors = 0
+	AccessorsRead Accessors = 1 << iota
+	AccessorsWrite
+)
@@ -0,0 +1,91 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[AccessorsNone-0]
+	_ = x[AccessorsRead-2]
+	_ = x[AccessorsWrite-4]
+}
+
+const (
+	_Accessors_name_0 = "NoneReadWrite"
+)
+
+var (
+	_Accessors_index_0 = [...]uint8{0, 4, 8, 13}
+)
+
+func (i Accessors) AppendFlags(s []string) []string {
+	if i == 0 {
+		return append(s, _Accessors_name_0[_Accessors_index_0[0]:_Accessors_index_0[1]])
+	}
+
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Accessors_name_0[_Accessors_index_0[1]:_Accessors_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Accessors_name_0[_Accessors_index_0[2]:_Accessors_index_0[3]])
+	}
+	if i != 0 {
+		s = append(s, "Accessors("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Accessors) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount(uint(i))))
+}
+
+func (i Accessors) AppendText(b []byte) ([]byte, error) {
+	if i == 0 {
+		return append(b, _Accessors_name_0[_Accessors_index_0[0]:_Accessors_index_0[1]]...), nil
+	}
+
+	n := len(b)
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Accessors_name_0[_Accessors_index_0[1]:_Accessors_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Accessors_name_0[_Accessors_index_0[2]:_Accessors_index_0[3]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Accessors("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Accessors) String() string {
+	var buf [48]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func (i Accessors) IsNone() bool { return i == 0 }
+
+func (i Accessors) IsRead() bool           { return i&AccessorsRead == AccessorsRead }
+func (i Accessors) WithRead() Accessors    { return i | AccessorsRead }
+func (i Accessors) WithoutRead() Accessors { return i & ^AccessorsRead }
+func (i Accessors) ToggleRead() Accessors  { return i ^ AccessorsRead }
+
+func (i Accessors) IsWrite() bool           { return i&AccessorsWrite == AccessorsWrite }
+func (i Accessors) WithWrite() Accessors    { return i | AccessorsWrite }
+func (i Accessors) WithoutWrite() Accessors { return i & ^AccessorsWrite }
+func (i Accessors) ToggleWrite() Accessors  { return i ^ AccessorsWrite }
@@ -0,0 +1,9 @@
+package test
+
+type AliasSize int
+
+const (
+	AliasSizeA AliasSize = 1 //stringer:alias "ay"
+	AliasSizeB AliasSize = 5
+	AliasSizeC AliasSize = 9 //stringer:alias "cee", "sea"
+)
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[AliasSizeA-1]
+	_ = x[AliasSizeB-5]
+	_ = x[AliasSizeC-9]
+}
+
+const _AliasSize_name = "ABC"
+
+var _AliasSize_values = [...]AliasSize{1, 5, 9}
+
+var _AliasSize_index = [...]uint8{0, 1, 2, 3}
+
+func (i AliasSize) String() string {
+	lo, hi := 0, len(_AliasSize_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _AliasSize_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_AliasSize_values) && _AliasSize_values[lo] == i {
+		return _AliasSize_name[_AliasSize_index[lo]:_AliasSize_index[lo+1]]
+	}
+	return "AliasSize(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+func _AliasSize_lookup(s string) (AliasSize, bool) {
+	for j, v := range _AliasSize_values {
+		if s == _AliasSize_name[_AliasSize_index[j]:_AliasSize_index[j+1]] {
+			return v, true
+		}
+	}
+	switch len(s) {
+	case 2:
+		if s == "ay" {
+			return AliasSizeA, true
+		}
+	case 3:
+		switch s[0] {
+		case 'c':
+			if s == "cee" {
+				return AliasSizeC, true
+			}
+		case 's':
+			if s == "sea" {
+				return AliasSizeC, true
+			}
+		}
+	}
+	return 0, false
+}
+
+var _AliasSize_names = [...]string{
+	_AliasSize_name[0:1],
+	_AliasSize_name[1:2],
+	_AliasSize_name[2:3],
+}
+
+// ParseAliasSize returns the AliasSize named s.
+func ParseAliasSize(s string) (AliasSize, error) {
+	if i, ok := _AliasSize_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "AliasSize", Input: s, Valid: append([]string(nil), _AliasSize_names[:]...), Err: enum.ErrUnknownName}
+}
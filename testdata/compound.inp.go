@@ -0,0 +1,13 @@
+package test
+
+type Compound uint
+
+const (
+	Read Compound = 1 << iota
+	Write
+	Execute
+
+	// Compound values are not single bits and are skipped.
+	ReadWrite = Read | Write
+	All       = Read | Write | Execute
+)
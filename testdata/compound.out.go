@@ -0,0 +1,80 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[Read-1]
+	_ = x[Write-2]
+	_ = x[Execute-4]
+}
+
+const (
+	_Compound_name_0 = "ReadWriteExecute"
+)
+
+var (
+	_Compound_index_0 = [...]uint8{0, 4, 9, 16}
+)
+
+func (i Compound) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _Compound_name_0[_Compound_index_0[0]:_Compound_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Compound_name_0[_Compound_index_0[1]:_Compound_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Compound_name_0[_Compound_index_0[2]:_Compound_index_0[3]])
+	}
+	if i != 0 {
+		s = append(s, "Compound("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Compound) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount(uint(i))))
+}
+
+func (i Compound) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _Compound_name_0[_Compound_index_0[0]:_Compound_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Compound_name_0[_Compound_index_0[1]:_Compound_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Compound_name_0[_Compound_index_0[2]:_Compound_index_0[3]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Compound("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Compound) String() string {
+	var buf [50]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
@@ -0,0 +1,13 @@
+package test
+
+type Day uint8
+
+const (
+	Monday Day = 1 << iota
+	Tuesday
+	Wednesday
+	Thursday
+	Friday
+	Saturday
+	Sunday
+)
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[Monday-1]
+	_ = x[Tuesday-2]
+	_ = x[Wednesday-4]
+	_ = x[Thursday-8]
+	_ = x[Friday-16]
+	_ = x[Saturday-32]
+	_ = x[Sunday-64]
+}
+
+const (
+	_Day_name_0 = "MondayTuesdayWednesdayThursdayFridaySaturdaySunday"
+)
+
+var (
+	_Day_index_0 = [...]uint8{0, 6, 13, 22, 30, 36, 44, 50}
+)
+
+func (i Day) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _Day_name_0[_Day_index_0[0]:_Day_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Day_name_0[_Day_index_0[1]:_Day_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Day_name_0[_Day_index_0[2]:_Day_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _Day_name_0[_Day_index_0[3]:_Day_index_0[4]])
+	}
+	if i&16 != 0 {
+		i, s = i&^16, append(s, _Day_name_0[_Day_index_0[4]:_Day_index_0[5]])
+	}
+	if i&32 != 0 {
+		i, s = i&^32, append(s, _Day_name_0[_Day_index_0[5]:_Day_index_0[6]])
+	}
+	if i&64 != 0 {
+		i, s = i&^64, append(s, _Day_name_0[_Day_index_0[6]:_Day_index_0[7]])
+	}
+	if i != 0 {
+		s = append(s, "Day("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Day) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i Day) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _Day_name_0[_Day_index_0[0]:_Day_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Day_name_0[_Day_index_0[1]:_Day_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Day_name_0[_Day_index_0[2]:_Day_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _Day_name_0[_Day_index_0[3]:_Day_index_0[4]]...)
+	}
+	if i&16 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^16, append(b, _Day_name_0[_Day_index_0[4]:_Day_index_0[5]]...)
+	}
+	if i&32 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^32, append(b, _Day_name_0[_Day_index_0[5]:_Day_index_0[6]]...)
+	}
+	if i&64 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^64, append(b, _Day_name_0[_Day_index_0[6]:_Day_index_0[7]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Day("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Day) String() string {
+	var buf [83]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
@@ -0,0 +1,15 @@
+package test
+
+type Deprecated int
+
+const (
+	DeprecatedLow Deprecated = iota
+
+	// DeprecatedMedium is too vague.
+	//
+	// Deprecated: Use DeprecatedHigh instead.
+	DeprecatedMedium
+
+	DeprecatedHigh
+	DeprecatedOld // Deprecated: Use DeprecatedLow instead.
+)
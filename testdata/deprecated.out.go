@@ -0,0 +1,95 @@
+package test
+
+import (
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[DeprecatedLow-0]
+	_ = x[DeprecatedMedium-1]
+	_ = x[DeprecatedHigh-2]
+	_ = x[DeprecatedOld-3]
+}
+
+const _Deprecated_name = "LowMediumHighOld"
+
+var _Deprecated_index = [...]uint8{0, 3, 9, 13, 16}
+
+func (i Deprecated) String() string {
+	if i < 0 || i >= Deprecated(len(_Deprecated_index)-1) {
+		return "Deprecated(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Deprecated_name[_Deprecated_index[i]:_Deprecated_index[i+1]]
+}
+
+func _Deprecated_lookup(s string) (Deprecated, bool) {
+	switch len(s) {
+	case 3:
+		switch s[0] {
+		case 'L':
+			if s == _Deprecated_name[0:3] {
+				return DeprecatedLow, true
+			}
+		case 'O':
+			if s == _Deprecated_name[13:16] {
+				return DeprecatedOld, true
+			}
+		}
+	case 4:
+		if s == _Deprecated_name[9:13] {
+			return DeprecatedHigh, true
+		}
+	case 6:
+		if s == _Deprecated_name[3:9] {
+			return DeprecatedMedium, true
+		}
+	}
+	return 0, false
+}
+
+var _Deprecated_names = [...]string{
+	_Deprecated_name[0:3],
+	_Deprecated_name[3:9],
+	_Deprecated_name[9:13],
+	_Deprecated_name[13:16],
+}
+
+func _Deprecated_parse(s string) (Deprecated, error) {
+	if i, ok := _Deprecated_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "Deprecated", Input: s, Valid: append([]string(nil), _Deprecated_names[:]...), Err: enum.ErrUnknownName}
+}
+
+func _Deprecated_deprecated(i Deprecated) bool {
+	switch i {
+	case DeprecatedMedium, DeprecatedOld:
+		return true
+	}
+	return false
+}
+
+// ParseDeprecated returns the Deprecated named s.
+// Deprecated values are reported to the handler set with enum.HandleDeprecated.
+func ParseDeprecated(s string) (Deprecated, error) {
+	i, err := _Deprecated_parse(s)
+	if err == nil && _Deprecated_deprecated(i) {
+		enum.Deprecated("Deprecated", s)
+	}
+	return i, err
+}
+
+// IsDeprecated reports whether i is deprecated.
+func (i Deprecated) IsDeprecated() bool {
+	return _Deprecated_deprecated(i)
+}
+
+// DeprecatedValues returns the values of Deprecated that are not deprecated.
+func DeprecatedValues() []Deprecated {
+	return []Deprecated{DeprecatedLow, DeprecatedHigh}
+}
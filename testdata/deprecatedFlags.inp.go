@@ -0,0 +1,11 @@
+package test
+
+type DeprecatedFlags uint8
+
+const (
+	DeprecatedFlagsRead DeprecatedFlags = 1 << iota
+	DeprecatedFlagsWrite
+
+	// Deprecated: Use DeprecatedFlagsWrite instead.
+	DeprecatedFlagsAppend
+)
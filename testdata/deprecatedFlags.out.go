@@ -0,0 +1,146 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[DeprecatedFlagsRead-1]
+	_ = x[DeprecatedFlagsWrite-2]
+	_ = x[DeprecatedFlagsAppend-4]
+}
+
+const (
+	_DeprecatedFlags_name_0 = "ReadWriteAppend"
+)
+
+var (
+	_DeprecatedFlags_index_0 = [...]uint8{0, 4, 9, 15}
+)
+
+func (i DeprecatedFlags) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _DeprecatedFlags_name_0[_DeprecatedFlags_index_0[0]:_DeprecatedFlags_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _DeprecatedFlags_name_0[_DeprecatedFlags_index_0[1]:_DeprecatedFlags_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _DeprecatedFlags_name_0[_DeprecatedFlags_index_0[2]:_DeprecatedFlags_index_0[3]])
+	}
+	if i != 0 {
+		s = append(s, "DeprecatedFlags("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i DeprecatedFlags) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i DeprecatedFlags) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _DeprecatedFlags_name_0[_DeprecatedFlags_index_0[0]:_DeprecatedFlags_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _DeprecatedFlags_name_0[_DeprecatedFlags_index_0[1]:_DeprecatedFlags_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _DeprecatedFlags_name_0[_DeprecatedFlags_index_0[2]:_DeprecatedFlags_index_0[3]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "DeprecatedFlags("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i DeprecatedFlags) String() string {
+	var buf [56]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func _DeprecatedFlags_lookup(s string) (DeprecatedFlags, bool) {
+	switch len(s) {
+	case 4:
+		if s == _DeprecatedFlags_name_0[0:4] {
+			return DeprecatedFlagsRead, true
+		}
+	case 5:
+		if s == _DeprecatedFlags_name_0[4:9] {
+			return DeprecatedFlagsWrite, true
+		}
+	case 6:
+		if s == _DeprecatedFlags_name_0[9:15] {
+			return DeprecatedFlagsAppend, true
+		}
+	}
+	return 0, false
+}
+
+var _DeprecatedFlags_names = [...]string{
+	_DeprecatedFlags_name_0[0:4],
+	_DeprecatedFlags_name_0[4:9],
+	_DeprecatedFlags_name_0[9:15],
+}
+
+func _DeprecatedFlags_parse(s string) (DeprecatedFlags, error) {
+	var i DeprecatedFlags
+	for s != "" {
+		name, rest, _ := strings.Cut(s, "+")
+		f, ok := _DeprecatedFlags_lookup(name)
+		if !ok {
+			return 0, &enum.ParseError{Type: "DeprecatedFlags", Input: name, Valid: append([]string(nil), _DeprecatedFlags_names[:]...), Err: enum.ErrUnknownName}
+		}
+		i, s = i|f, rest
+	}
+	return i, nil
+}
+
+func _DeprecatedFlags_deprecated(i DeprecatedFlags) bool {
+	return i&DeprecatedFlagsAppend != 0
+}
+
+// ParseDeprecatedFlags returns the DeprecatedFlags made of the flags named in s, separated by
+// '+'. The empty string stands for no flags.
+// Deprecated values are reported to the handler set with enum.HandleDeprecated.
+func ParseDeprecatedFlags(s string) (DeprecatedFlags, error) {
+	i, err := _DeprecatedFlags_parse(s)
+	if err == nil && _DeprecatedFlags_deprecated(i) {
+		enum.Deprecated("DeprecatedFlags", s)
+	}
+	return i, err
+}
+
+// IsDeprecated reports whether i is deprecated.
+// A value is if any of its flags is.
+func (i DeprecatedFlags) IsDeprecated() bool {
+	return _DeprecatedFlags_deprecated(i)
+}
+
+// DeprecatedFlagsValues returns the values of DeprecatedFlags.
+func DeprecatedFlagsValues() []DeprecatedFlags {
+	return []DeprecatedFlags{DeprecatedFlagsRead, DeprecatedFlagsWrite, DeprecatedFlagsAppend}
+}
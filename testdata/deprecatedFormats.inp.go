@@ -0,0 +1,11 @@
+package test
+
+type DeprecatedFormats int
+
+const (
+	DeprecatedFormatsNone DeprecatedFormats = iota //stringer:json none
+
+	// Deprecated: Use DeprecatedFormatsNone.
+	DeprecatedFormatsNil //stringer:json nil
+	DeprecatedFormatsSome
+)
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[DeprecatedFormatsNone-0]
+	_ = x[DeprecatedFormatsNil-1]
+	_ = x[DeprecatedFormatsSome-2]
+}
+
+const _DeprecatedFormats_name = "NoneNilSome"
+
+var _DeprecatedFormats_index = [...]uint8{0, 4, 7, 11}
+
+func (i DeprecatedFormats) String() string {
+	if i < 0 || i >= DeprecatedFormats(len(_DeprecatedFormats_index)-1) {
+		return "DeprecatedFormats(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _DeprecatedFormats_name[_DeprecatedFormats_index[i]:_DeprecatedFormats_index[i+1]]
+}
+
+// _DeprecatedFormats_ordinal returns the position of i among the values of DeprecatedFormats, or -1.
+func _DeprecatedFormats_ordinal(i DeprecatedFormats) int {
+	switch {
+	case i >= 0 && i <= 2:
+		return int(i)
+	}
+	return -1
+}
+
+const _DeprecatedFormats_json_name = "nonenilSome"
+
+var _DeprecatedFormats_json_index = [...]uint8{0, 4, 7, 11}
+
+// JSONName returns the name of i in JSON.
+func (i DeprecatedFormats) JSONName() string {
+	if j := _DeprecatedFormats_ordinal(i); j >= 0 {
+		return _DeprecatedFormats_json_name[_DeprecatedFormats_json_index[j]:_DeprecatedFormats_json_index[j+1]]
+	}
+	return i.String()
+}
+
+var _DeprecatedFormats_json_names = [...]string{
+	_DeprecatedFormats_json_name[0:4],
+	_DeprecatedFormats_json_name[4:7],
+	_DeprecatedFormats_json_name[7:11],
+}
+
+func _DeprecatedFormats_json_match(s string) (DeprecatedFormats, error) {
+	switch len(s) {
+	case 3:
+		if s == _DeprecatedFormats_json_name[4:7] {
+			return DeprecatedFormatsNil, nil
+		}
+	case 4:
+		switch s[0] {
+		case 'S':
+			if s == _DeprecatedFormats_json_name[7:11] {
+				return DeprecatedFormatsSome, nil
+			}
+		case 'n':
+			if s == _DeprecatedFormats_json_name[0:4] {
+				return DeprecatedFormatsNone, nil
+			}
+		}
+	}
+	return 0, &enum.ParseError{Type: "DeprecatedFormats", Input: s, Valid: append([]string(nil), _DeprecatedFormats_json_names[:]...), Err: enum.ErrUnknownName}
+}
+
+func _DeprecatedFormats_deprecated(i DeprecatedFormats) bool {
+	switch i {
+	case DeprecatedFormatsNil:
+		return true
+	}
+	return false
+}
+
+// Deprecated values are reported to the handler set with enum.HandleDeprecated.
+func _DeprecatedFormats_json_parse(s string) (DeprecatedFormats, error) {
+	i, err := _DeprecatedFormats_json_match(s)
+	if err == nil && _DeprecatedFormats_deprecated(i) {
+		enum.Deprecated("DeprecatedFormats", s)
+	}
+	return i, err
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i DeprecatedFormats) MarshalJSON() ([]byte, error) {
+	if _DeprecatedFormats_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "DeprecatedFormats", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return json.Marshal(i.JSONName())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *DeprecatedFormats) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := _DeprecatedFormats_json_parse(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// IsDeprecated reports whether i is deprecated.
+func (i DeprecatedFormats) IsDeprecated() bool {
+	return _DeprecatedFormats_deprecated(i)
+}
@@ -0,0 +1,19 @@
+package test
+
+type Description int
+
+const (
+	// DescriptionLow is the lowest level.
+	//
+	// It is also the default.
+	DescriptionLow Description = iota
+
+	// DescriptionHigh is the highest level,
+	// spanning two lines.
+	//
+	//stringer:name "high"
+	DescriptionHigh
+
+	DescriptionOff  Description = iota + 8 // Turned off.
+	DescriptionNone                        //stringer:alias "none"
+)
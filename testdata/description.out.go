@@ -0,0 +1,60 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[DescriptionLow-0]
+	_ = x[DescriptionHigh-1]
+	_ = x[DescriptionOff-10]
+	_ = x[DescriptionNone-11]
+}
+
+const (
+	_Description_name_0 = "Lowhigh"
+	_Description_name_1 = "OffNone"
+)
+
+var (
+	_Description_index_0 = [...]uint8{0, 3, 7}
+	_Description_index_1 = [...]uint8{0, 3, 7}
+)
+
+func (i Description) String() string {
+	switch {
+	case 0 <= i && i <= 1:
+		return _Description_name_0[_Description_index_0[i]:_Description_index_0[i+1]]
+	case 10 <= i && i <= 11:
+		i -= 10
+		return _Description_name_1[_Description_index_1[i]:_Description_index_1[i+1]]
+	default:
+		return "Description(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// _Description_ordinal returns the position of i among the values of Description, or -1.
+func _Description_ordinal(i Description) int {
+	switch {
+	case i >= 0 && i <= 1:
+		return int(i)
+	case i >= 10 && i <= 11:
+		return int(i-10) + 2
+	}
+	return -1
+}
+
+const _Description_description = "DescriptionLow is the lowest level.\n\nIt is also the default.DescriptionHigh is the highest level, spanning two lines.Turned off."
+
+var _Description_description_index = [...]uint8{0, 60, 117, 128, 128}
+
+// Description returns the documentation of i, or "" for unknown values.
+func (i Description) Description() string {
+	if j := _Description_ordinal(i); j >= 0 {
+		return _Description_description[_Description_description_index[j]:_Description_description_index[j+1]]
+	}
+	return ""
+}
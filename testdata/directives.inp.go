@@ -0,0 +1,14 @@
+package test
+
+type Directives int
+
+const (
+	// DirectivesFoo is renamed, and still accepts its old spellings.
+	//
+	//stringer:name "foo"
+	//stringer:alias "f", "legacy-foo"
+	DirectivesFoo Directives = iota
+
+	DirectivesBar //stringer:alias "b"
+	DirectivesBaz // the baz
+)
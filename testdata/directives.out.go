@@ -0,0 +1,77 @@
+package test
+
+import (
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[DirectivesFoo-0]
+	_ = x[DirectivesBar-1]
+	_ = x[DirectivesBaz-2]
+}
+
+const _Directives_name = "fooBarthe baz"
+
+var _Directives_index = [...]uint8{0, 3, 6, 13}
+
+func (i Directives) String() string {
+	if i < 0 || i >= Directives(len(_Directives_index)-1) {
+		return "Directives(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Directives_name[_Directives_index[i]:_Directives_index[i+1]]
+}
+
+func _Directives_lookup(s string) (Directives, bool) {
+	switch len(s) {
+	case 1:
+		switch s[0] {
+		case 'b':
+			if s == "b" {
+				return DirectivesBar, true
+			}
+		case 'f':
+			if s == "f" {
+				return DirectivesFoo, true
+			}
+		}
+	case 3:
+		switch s[0] {
+		case 'B':
+			if s == _Directives_name[3:6] {
+				return DirectivesBar, true
+			}
+		case 'f':
+			if s == _Directives_name[0:3] {
+				return DirectivesFoo, true
+			}
+		}
+	case 7:
+		if s == _Directives_name[6:13] {
+			return DirectivesBaz, true
+		}
+	case 10:
+		if s == "legacy-foo" {
+			return DirectivesFoo, true
+		}
+	}
+	return 0, false
+}
+
+var _Directives_names = [...]string{
+	_Directives_name[0:3],
+	_Directives_name[3:6],
+	_Directives_name[6:13],
+}
+
+// ParseDirectives returns the Directives named s.
+func ParseDirectives(s string) (Directives, error) {
+	if i, ok := _Directives_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "Directives", Input: s, Valid: append([]string(nil), _Directives_names[:]...), Err: enum.ErrUnknownName}
+}
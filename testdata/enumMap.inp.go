@@ -0,0 +1,9 @@
+package test
+
+type EnumMap uint8
+
+const (
+	EnumMapGet EnumMap = iota + 1
+	EnumMapPut
+	EnumMapDelete
+)
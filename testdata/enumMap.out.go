@@ -0,0 +1,141 @@
+package test
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[EnumMapGet-1]
+	_ = x[EnumMapPut-2]
+	_ = x[EnumMapDelete-3]
+}
+
+const _EnumMap_name = "GetPutDelete"
+
+var _EnumMap_index = [...]uint8{0, 3, 6, 12}
+
+func (i EnumMap) String() string {
+	i -= 1
+	if i >= EnumMap(len(_EnumMap_index)-1) {
+		return "EnumMap(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _EnumMap_name[_EnumMap_index[i]:_EnumMap_index[i+1]]
+}
+
+func _EnumMap_lookup(s string) (EnumMap, bool) {
+	switch len(s) {
+	case 3:
+		switch s[0] {
+		case 'G':
+			if s == _EnumMap_name[0:3] {
+				return EnumMapGet, true
+			}
+		case 'P':
+			if s == _EnumMap_name[3:6] {
+				return EnumMapPut, true
+			}
+		}
+	case 6:
+		if s == _EnumMap_name[6:12] {
+			return EnumMapDelete, true
+		}
+	}
+	return 0, false
+}
+
+var _EnumMap_names = [...]string{
+	_EnumMap_name[0:3],
+	_EnumMap_name[3:6],
+	_EnumMap_name[6:12],
+}
+
+// ParseEnumMap returns the EnumMap named s.
+func ParseEnumMap(s string) (EnumMap, error) {
+	if i, ok := _EnumMap_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "EnumMap", Input: s, Valid: append([]string(nil), _EnumMap_names[:]...), Err: enum.ErrUnknownName}
+}
+
+// EnumMapCount is the number of values of EnumMap.
+const EnumMapCount = 3
+
+// EnumMapMap maps each EnumMap value to a V, holding them in an array rather than
+// a Go map. Keys must be values of EnumMap.
+type EnumMapMap[V any] struct {
+	values [EnumMapCount]V
+}
+
+// Get returns the element of k, or the zero value of V if k is not a value
+// of EnumMap.
+func (m *EnumMapMap[V]) Get(k EnumMap) V {
+	if j := uint(k - EnumMapGet); j < EnumMapCount {
+		return m.values[j]
+	}
+	var zero V
+	return zero
+}
+
+// Set sets the element of k to v. Keys that are not values of EnumMap are
+// ignored.
+func (m *EnumMapMap[V]) Set(k EnumMap, v V) {
+	if j := uint(k - EnumMapGet); j < EnumMapCount {
+		m.values[j] = v
+	}
+}
+
+// Range calls f for each key and its element in increasing order of keys,
+// until f returns false.
+func (m *EnumMapMap[V]) Range(f func(k EnumMap, v V) bool) {
+	for j, v := range m.values {
+		if !f(EnumMap(j)+EnumMapGet, v) {
+			return
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding m as an object keyed by
+// the names of the keys.
+func (m EnumMapMap[V]) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for j, v := range m.values {
+		if j > 0 {
+			b = append(b, ',')
+		}
+		key, err := json.Marshal((EnumMap(j) + EnumMapGet).String())
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		b = append(append(append(b, key...), ':'), value...)
+	}
+	return append(b, '}'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Keys left out keep their
+// elements, and names of no key are an error.
+func (m *EnumMapMap[V]) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for name, value := range raw {
+		k, err := ParseEnumMap(name)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(value, &m.values[k-EnumMapGet]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
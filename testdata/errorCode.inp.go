@@ -0,0 +1,9 @@
+package test
+
+type ErrorCode int
+
+const (
+	ErrorCodeNotFound    ErrorCode = iota + 1 //stringer:display "not found"
+	ErrorCodePermission                       //stringer:display "permission denied"
+	ErrorCodeUnavailable                      //stringer:display "service unavailable"
+)
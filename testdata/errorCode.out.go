@@ -0,0 +1,62 @@
+package test
+
+import (
+	"errors"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ErrorCodeNotFound-1]
+	_ = x[ErrorCodePermission-2]
+	_ = x[ErrorCodeUnavailable-3]
+}
+
+const _ErrorCode_name = "NotFoundPermissionUnavailable"
+
+var _ErrorCode_index = [...]uint8{0, 8, 18, 29}
+
+func (i ErrorCode) String() string {
+	i -= 1
+	if i < 0 || i >= ErrorCode(len(_ErrorCode_index)-1) {
+		return "ErrorCode(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _ErrorCode_name[_ErrorCode_index[i]:_ErrorCode_index[i+1]]
+}
+
+// _ErrorCode_ordinal returns the position of i among the values of ErrorCode, or -1.
+func _ErrorCode_ordinal(i ErrorCode) int {
+	switch {
+	case i >= 1 && i <= 3:
+		return int(i - 1)
+	}
+	return -1
+}
+
+const _ErrorCode_display_name = "not foundpermission deniedservice unavailable"
+
+var _ErrorCode_display_index = [...]uint8{0, 9, 26, 45}
+
+// DisplayName returns the name of i to display to people.
+func (i ErrorCode) DisplayName() string {
+	if j := _ErrorCode_ordinal(i); j >= 0 {
+		return _ErrorCode_display_name[_ErrorCode_display_index[j]:_ErrorCode_display_index[j+1]]
+	}
+	return i.String()
+}
+
+// Error implements the error interface.
+func (i ErrorCode) Error() string {
+	return i.DisplayName()
+}
+
+// ErrorCodeFromError returns the first ErrorCode in the chain of err.
+func ErrorCodeFromError(err error) (ErrorCode, bool) {
+	var i ErrorCode
+	if errors.As(err, &i) {
+		return i, true
+	}
+	return 0, false
+}
@@ -0,0 +1,9 @@
+package test
+
+type ErrorDescription int
+
+const (
+	ErrorDescriptionTimeout  ErrorDescription = iota + 1 // The operation timed out.
+	ErrorDescriptionConflict                             // The resource was modified concurrently.
+	ErrorDescriptionInternal
+)
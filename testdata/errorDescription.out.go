@@ -0,0 +1,65 @@
+package test
+
+import (
+	"errors"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ErrorDescriptionTimeout-1]
+	_ = x[ErrorDescriptionConflict-2]
+	_ = x[ErrorDescriptionInternal-3]
+}
+
+const _ErrorDescription_name = "TimeoutConflictInternal"
+
+var _ErrorDescription_index = [...]uint8{0, 7, 15, 23}
+
+func (i ErrorDescription) String() string {
+	i -= 1
+	if i < 0 || i >= ErrorDescription(len(_ErrorDescription_index)-1) {
+		return "ErrorDescription(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _ErrorDescription_name[_ErrorDescription_index[i]:_ErrorDescription_index[i+1]]
+}
+
+// _ErrorDescription_ordinal returns the position of i among the values of ErrorDescription, or -1.
+func _ErrorDescription_ordinal(i ErrorDescription) int {
+	switch {
+	case i >= 1 && i <= 3:
+		return int(i - 1)
+	}
+	return -1
+}
+
+const _ErrorDescription_description = "The operation timed out.The resource was modified concurrently."
+
+var _ErrorDescription_description_index = [...]uint8{0, 24, 63, 63}
+
+// Description returns the documentation of i, or "" for unknown values.
+func (i ErrorDescription) Description() string {
+	if j := _ErrorDescription_ordinal(i); j >= 0 {
+		return _ErrorDescription_description[_ErrorDescription_description_index[j]:_ErrorDescription_description_index[j+1]]
+	}
+	return ""
+}
+
+// Error implements the error interface.
+func (i ErrorDescription) Error() string {
+	if s := i.Description(); s != "" {
+		return s
+	}
+	return i.String()
+}
+
+// ErrorDescriptionFromError returns the first ErrorDescription in the chain of err.
+func ErrorDescriptionFromError(err error) (ErrorDescription, bool) {
+	var i ErrorDescription
+	if errors.As(err, &i) {
+		return i, true
+	}
+	return 0, false
+}
@@ -0,0 +1,8 @@
+package test
+
+type ErrorFormat int
+
+const (
+	ErrorFormatNotFound   ErrorFormat = iota + 1 //stringer:display "not found"
+	ErrorFormatPermission                        //stringer:display "permission denied"
+)
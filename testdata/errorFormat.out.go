@@ -0,0 +1,80 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ErrorFormatNotFound-1]
+	_ = x[ErrorFormatPermission-2]
+}
+
+const _ErrorFormat_name = "NotFoundPermission"
+
+var _ErrorFormat_index = [...]uint8{0, 8, 18}
+
+func (i ErrorFormat) String() string {
+	i -= 1
+	if i < 0 || i >= ErrorFormat(len(_ErrorFormat_index)-1) {
+		return "ErrorFormat(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _ErrorFormat_name[_ErrorFormat_index[i]:_ErrorFormat_index[i+1]]
+}
+
+// _ErrorFormat_ordinal returns the position of i among the values of ErrorFormat, or -1.
+func _ErrorFormat_ordinal(i ErrorFormat) int {
+	switch {
+	case i >= 1 && i <= 2:
+		return int(i - 1)
+	}
+	return -1
+}
+
+const _ErrorFormat_display_name = "not foundpermission denied"
+
+var _ErrorFormat_display_index = [...]uint8{0, 9, 26}
+
+// DisplayName returns the name of i to display to people.
+func (i ErrorFormat) DisplayName() string {
+	if j := _ErrorFormat_ordinal(i); j >= 0 {
+		return _ErrorFormat_display_name[_ErrorFormat_display_index[j]:_ErrorFormat_display_index[j+1]]
+	}
+	return i.String()
+}
+
+// Format implements fmt.Formatter: the verbs b, d, o, O, x and X print the
+// number of i, %+v its name followed by the number in parentheses, and the
+// other verbs its error message.
+func (i ErrorFormat) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'b', 'd', 'o', 'O', 'x', 'X':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(i))
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "%s(%d)", i.String(), int64(i))
+			return
+		}
+		fallthrough
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), i.Error())
+	}
+}
+
+// Error implements the error interface.
+func (i ErrorFormat) Error() string {
+	return i.DisplayName()
+}
+
+// ErrorFormatFromError returns the first ErrorFormat in the chain of err.
+func ErrorFormatFromError(err error) (ErrorFormat, bool) {
+	var i ErrorFormat
+	if errors.As(err, &i) {
+		return i, true
+	}
+	return 0, false
+}
@@ -0,0 +1,13 @@
+package test
+
+type Forced int
+
+const (
+	ForcedContinue  Forced = 100
+	ForcedSwitching Forced = 101
+
+	ForcedOK        Forced = 200
+	ForcedCreated   Forced = 201
+	ForcedAccepted  Forced = 202
+	ForcedNoContent Forced = 204
+)
@@ -0,0 +1,39 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ForcedContinue-100]
+	_ = x[ForcedSwitching-101]
+	_ = x[ForcedOK-200]
+	_ = x[ForcedCreated-201]
+	_ = x[ForcedAccepted-202]
+	_ = x[ForcedNoContent-204]
+}
+
+const _Forced_name = "ContinueSwitchingOKCreatedAcceptedNoContent"
+
+var _Forced_values = [...]Forced{100, 101, 200, 201, 202, 204}
+
+var _Forced_index = [...]uint8{0, 8, 17, 19, 26, 34, 43}
+
+func (i Forced) String() string {
+	lo, hi := 0, len(_Forced_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _Forced_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_Forced_values) && _Forced_values[lo] == i {
+		return _Forced_name[_Forced_index[lo]:_Forced_index[lo+1]]
+	}
+	return "Forced(" + strconv.FormatInt(int64(i), 10) + ")"
+}
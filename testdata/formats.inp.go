@@ -0,0 +1,15 @@
+package test
+
+type Formats int
+
+const (
+	FormatsOK       Formats = iota //stringer:json ok display="OK"
+	FormatsNotFound                //stringer:json not_found display="Not Found"
+	FormatsGone
+
+	// FormatsTeapot has a name in text encodings of its own.
+	//
+	//stringer:alias "coffee-pot"
+	//stringer:text teapot display="I'm a teapot"
+	FormatsTeapot Formats = 18
+)
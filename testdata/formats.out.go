@@ -0,0 +1,191 @@
+package test
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[FormatsOK-0]
+	_ = x[FormatsNotFound-1]
+	_ = x[FormatsGone-2]
+	_ = x[FormatsTeapot-18]
+}
+
+const (
+	_Formats_name_0 = "OKNotFoundGone"
+	_Formats_name_1 = "Teapot"
+)
+
+var (
+	_Formats_index_0 = [...]uint8{0, 2, 10, 14}
+)
+
+func (i Formats) String() string {
+	switch {
+	case 0 <= i && i <= 2:
+		return _Formats_name_0[_Formats_index_0[i]:_Formats_index_0[i+1]]
+	case i == 18:
+		return _Formats_name_1
+	default:
+		return "Formats(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// _Formats_ordinal returns the position of i among the values of Formats, or -1.
+func _Formats_ordinal(i Formats) int {
+	switch {
+	case i >= 0 && i <= 2:
+		return int(i)
+	case i == 18:
+		return 3
+	}
+	return -1
+}
+
+const _Formats_json_name = "oknot_foundGoneTeapot"
+
+var _Formats_json_index = [...]uint8{0, 2, 11, 15, 21}
+
+// JSONName returns the name of i in JSON.
+func (i Formats) JSONName() string {
+	if j := _Formats_ordinal(i); j >= 0 {
+		return _Formats_json_name[_Formats_json_index[j]:_Formats_json_index[j+1]]
+	}
+	return i.String()
+}
+
+const _Formats_text_name = "OKNotFoundGoneteapot"
+
+var _Formats_text_index = [...]uint8{0, 2, 10, 14, 20}
+
+// TextName returns the name of i in text encodings.
+func (i Formats) TextName() string {
+	if j := _Formats_ordinal(i); j >= 0 {
+		return _Formats_text_name[_Formats_text_index[j]:_Formats_text_index[j+1]]
+	}
+	return i.String()
+}
+
+const _Formats_display_name = "OKNot FoundGoneI'm a teapot"
+
+var _Formats_display_index = [...]uint8{0, 2, 11, 15, 27}
+
+// DisplayName returns the name of i to display to people.
+func (i Formats) DisplayName() string {
+	if j := _Formats_ordinal(i); j >= 0 {
+		return _Formats_display_name[_Formats_display_index[j]:_Formats_display_index[j+1]]
+	}
+	return i.String()
+}
+
+var _Formats_text_names = [...]string{
+	_Formats_text_name[0:2],
+	_Formats_text_name[2:10],
+	_Formats_text_name[10:14],
+	_Formats_text_name[14:20],
+}
+
+func _Formats_text_parse(s string) (Formats, error) {
+	switch len(s) {
+	case 2:
+		if s == _Formats_text_name[0:2] {
+			return FormatsOK, nil
+		}
+	case 4:
+		if s == _Formats_text_name[10:14] {
+			return FormatsGone, nil
+		}
+	case 6:
+		if s == _Formats_text_name[14:20] {
+			return FormatsTeapot, nil
+		}
+	case 8:
+		if s == _Formats_text_name[2:10] {
+			return FormatsNotFound, nil
+		}
+	case 10:
+		if s == "coffee-pot" {
+			return FormatsTeapot, nil
+		}
+	}
+	return 0, &enum.ParseError{Type: "Formats", Input: s, Valid: append([]string(nil), _Formats_text_names[:]...), Err: enum.ErrUnknownName}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i Formats) MarshalText() ([]byte, error) {
+	if _Formats_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "Formats", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return []byte(i.TextName()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *Formats) UnmarshalText(b []byte) error {
+	v, err := _Formats_text_parse(string(b))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+var _Formats_json_names = [...]string{
+	_Formats_json_name[0:2],
+	_Formats_json_name[2:11],
+	_Formats_json_name[11:15],
+	_Formats_json_name[15:21],
+}
+
+func _Formats_json_parse(s string) (Formats, error) {
+	switch len(s) {
+	case 2:
+		if s == _Formats_json_name[0:2] {
+			return FormatsOK, nil
+		}
+	case 4:
+		if s == _Formats_json_name[11:15] {
+			return FormatsGone, nil
+		}
+	case 6:
+		if s == _Formats_json_name[15:21] {
+			return FormatsTeapot, nil
+		}
+	case 9:
+		if s == _Formats_json_name[2:11] {
+			return FormatsNotFound, nil
+		}
+	case 10:
+		if s == "coffee-pot" {
+			return FormatsTeapot, nil
+		}
+	}
+	return 0, &enum.ParseError{Type: "Formats", Input: s, Valid: append([]string(nil), _Formats_json_names[:]...), Err: enum.ErrUnknownName}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i Formats) MarshalJSON() ([]byte, error) {
+	if _Formats_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "Formats", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return json.Marshal(i.JSONName())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Formats) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := _Formats_json_parse(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
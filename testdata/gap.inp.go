@@ -0,0 +1,14 @@
+package test
+
+type Gap uint
+
+const (
+	Two    Gap = 1 << 2
+	Three  Gap = 1 << 3
+	Five   Gap = 1 << 5
+	Six    Gap = 1 << 6
+	Seven  Gap = 1 << 7
+	Eight  Gap = 1 << 8
+	Nine   Gap = 1 << 9
+	Eleven Gap = 1 << 11
+)
@@ -0,0 +1,133 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[Two-4]
+	_ = x[Three-8]
+	_ = x[Five-32]
+	_ = x[Six-64]
+	_ = x[Seven-128]
+	_ = x[Eight-256]
+	_ = x[Nine-512]
+	_ = x[Eleven-2048]
+}
+
+const (
+	_Gap_name_0 = "TwoThree"
+	_Gap_name_1 = "FiveSixSevenEightNine"
+	_Gap_name_2 = "Eleven"
+)
+
+var (
+	_Gap_index_0 = [...]uint8{0, 3, 8}
+	_Gap_index_1 = [...]uint8{0, 4, 7, 12, 17, 21}
+)
+
+func (i Gap) AppendFlags(s []string) []string {
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Gap_name_0[_Gap_index_0[0]:_Gap_index_0[1]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _Gap_name_0[_Gap_index_0[1]:_Gap_index_0[2]])
+	}
+	if i&32 != 0 {
+		i, s = i&^32, append(s, _Gap_name_1[_Gap_index_1[0]:_Gap_index_1[1]])
+	}
+	if i&64 != 0 {
+		i, s = i&^64, append(s, _Gap_name_1[_Gap_index_1[1]:_Gap_index_1[2]])
+	}
+	if i&128 != 0 {
+		i, s = i&^128, append(s, _Gap_name_1[_Gap_index_1[2]:_Gap_index_1[3]])
+	}
+	if i&256 != 0 {
+		i, s = i&^256, append(s, _Gap_name_1[_Gap_index_1[3]:_Gap_index_1[4]])
+	}
+	if i&512 != 0 {
+		i, s = i&^512, append(s, _Gap_name_1[_Gap_index_1[4]:_Gap_index_1[5]])
+	}
+	if i&2048 != 0 {
+		i, s = i&^2048, append(s, _Gap_name_2)
+	}
+	if i != 0 {
+		s = append(s, "Gap("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Gap) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount(uint(i))))
+}
+
+func (i Gap) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Gap_name_0[_Gap_index_0[0]:_Gap_index_0[1]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _Gap_name_0[_Gap_index_0[1]:_Gap_index_0[2]]...)
+	}
+	if i&32 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^32, append(b, _Gap_name_1[_Gap_index_1[0]:_Gap_index_1[1]]...)
+	}
+	if i&64 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^64, append(b, _Gap_name_1[_Gap_index_1[1]:_Gap_index_1[2]]...)
+	}
+	if i&128 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^128, append(b, _Gap_name_1[_Gap_index_1[2]:_Gap_index_1[3]]...)
+	}
+	if i&256 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^256, append(b, _Gap_name_1[_Gap_index_1[3]:_Gap_index_1[4]]...)
+	}
+	if i&512 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^512, append(b, _Gap_name_1[_Gap_index_1[4]:_Gap_index_1[5]]...)
+	}
+	if i&2048 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2048, append(b, _Gap_name_2...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Gap("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Gap) String() string {
+	var buf [69]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
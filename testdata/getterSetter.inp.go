@@ -0,0 +1,10 @@
+package test
+
+type GetterSetter uint
+
+const (
+	GetterSetterNone GetterSetter = 0
+	GetterSetterRead GetterSetter = 1 << iota
+	GetterSetterWrite
+	GetterSetterExecute
+)
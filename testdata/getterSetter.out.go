@@ -0,0 +1,103 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[GetterSetterNone-0]
+	_ = x[GetterSetterRead-2]
+	_ = x[GetterSetterWrite-4]
+	_ = x[GetterSetterExecute-8]
+}
+
+const (
+	_GetterSetter_name_0 = "NoneReadWriteExecute"
+)
+
+var (
+	_GetterSetter_index_0 = [...]uint8{0, 4, 8, 13, 20}
+)
+
+func (i GetterSetter) AppendFlags(s []string) []string {
+	if i == 0 {
+		return append(s, _GetterSetter_name_0[_GetterSetter_index_0[0]:_GetterSetter_index_0[1]])
+	}
+
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _GetterSetter_name_0[_GetterSetter_index_0[1]:_GetterSetter_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _GetterSetter_name_0[_GetterSetter_index_0[2]:_GetterSetter_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _GetterSetter_name_0[_GetterSetter_index_0[3]:_GetterSetter_index_0[4]])
+	}
+	if i != 0 {
+		s = append(s, "GetterSetter("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i GetterSetter) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount(uint(i))))
+}
+
+func (i GetterSetter) AppendText(b []byte) ([]byte, error) {
+	if i == 0 {
+		return append(b, _GetterSetter_name_0[_GetterSetter_index_0[0]:_GetterSetter_index_0[1]]...), nil
+	}
+
+	n := len(b)
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _GetterSetter_name_0[_GetterSetter_index_0[1]:_GetterSetter_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _GetterSetter_name_0[_GetterSetter_index_0[2]:_GetterSetter_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _GetterSetter_name_0[_GetterSetter_index_0[3]:_GetterSetter_index_0[4]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "GetterSetter("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i GetterSetter) String() string {
+	var buf [59]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func (i GetterSetter) None() bool { return i == 0 }
+
+func (i GetterSetter) Read() bool              { return i&GetterSetterRead == GetterSetterRead }
+func (i GetterSetter) SetRead() GetterSetter   { return i | GetterSetterRead }
+func (i GetterSetter) ClearRead() GetterSetter { return i & ^GetterSetterRead }
+
+func (i GetterSetter) Write() bool              { return i&GetterSetterWrite == GetterSetterWrite }
+func (i GetterSetter) SetWrite() GetterSetter   { return i | GetterSetterWrite }
+func (i GetterSetter) ClearWrite() GetterSetter { return i & ^GetterSetterWrite }
+
+func (i GetterSetter) Execute() bool              { return i&GetterSetterExecute == GetterSetterExecute }
+func (i GetterSetter) SetExecute() GetterSetter   { return i | GetterSetterExecute }
+func (i GetterSetter) ClearExecute() GetterSetter { return i & ^GetterSetterExecute }
@@ -0,0 +1,10 @@
+package test
+
+type I18n int
+
+const (
+	I18nRed I18n = iota
+	I18nGreen
+	I18nBlue
+	I18nBlack I18n = 10
+)
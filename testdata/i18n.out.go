@@ -0,0 +1,88 @@
+package test
+
+import (
+	"strconv"
+	"strings"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[I18nRed-0]
+	_ = x[I18nGreen-1]
+	_ = x[I18nBlue-2]
+	_ = x[I18nBlack-10]
+}
+
+const (
+	_I18n_name_0 = "RedGreenBlue"
+	_I18n_name_1 = "Black"
+)
+
+var (
+	_I18n_index_0 = [...]uint8{0, 3, 8, 12}
+)
+
+func (i I18n) String() string {
+	switch {
+	case 0 <= i && i <= 2:
+		return _I18n_name_0[_I18n_index_0[i]:_I18n_index_0[i+1]]
+	case i == 10:
+		return _I18n_name_1
+	default:
+		return "I18n(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// _I18n_ordinal returns the position of i among the values of I18n, or -1.
+func _I18n_ordinal(i I18n) int {
+	switch {
+	case i >= 0 && i <= 2:
+		return int(i)
+	case i == 10:
+		return 3
+	}
+	return -1
+}
+
+const _I18n_l10n_de = "RotGrünBlauSchwarz"
+
+var _I18n_l10n_de_index = [...]uint8{0, 3, 8, 12, 19}
+
+const _I18n_l10n_fr = "RougeVertNoir"
+
+var _I18n_l10n_fr_index = [...]uint8{0, 5, 9, 9, 13}
+
+const _I18n_l10n_pt_br = "VermelhoVerdeAzulPreto"
+
+var _I18n_l10n_pt_br_index = [...]uint8{0, 8, 13, 17, 22}
+
+// Localized returns the name of i in the language lang, such as "de" or
+// "de-AT", falling back to the language without region and to String.
+// Language tags match regardless of case and of subtags being separated
+// by '-' or '_', so "pt_BR" and "PT-br" both stand for "pt-BR".
+func (i I18n) Localized(lang string) string {
+	j := _I18n_ordinal(i)
+	if j < 0 {
+		return i.String()
+	}
+
+	lang = strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+	var s string
+	switch lang {
+	case "de":
+		s = _I18n_l10n_de[_I18n_l10n_de_index[j]:_I18n_l10n_de_index[j+1]]
+	case "fr":
+		s = _I18n_l10n_fr[_I18n_l10n_fr_index[j]:_I18n_l10n_fr_index[j+1]]
+	case "pt-br":
+		s = _I18n_l10n_pt_br[_I18n_l10n_pt_br_index[j]:_I18n_l10n_pt_br_index[j+1]]
+	}
+	if s != "" {
+		return s
+	}
+	if k := strings.IndexByte(lang, '-'); k > 0 {
+		return i.Localized(lang[:k])
+	}
+	return i.String()
+}
@@ -0,0 +1,22 @@
+package test
+
+type IdentRename int
+
+const (
+	IdentRenameA IdentRename = iota
+	IdentRenameB
+	IdentRenameC
+)
+
+// ParseIdentRename parses names as they were spelled by the first version.
+func ParseIdentRename(s string) (IdentRename, error) {
+	return parseIdentRename("IdentRename" + s)
+}
+
+func IdentRenameValues() []IdentRename { return nil }
+
+func NewIdentRenameSet() {}
+
+type IdentRenameMap map[IdentRename]string
+
+const IdentRenameCount = 2
@@ -0,0 +1,295 @@
+package test
+
+import (
+	"encoding/json"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[IdentRenameA-0]
+	_ = x[IdentRenameB-1]
+	_ = x[IdentRenameC-2]
+}
+
+const _IdentRename_name = "ABC"
+
+var _IdentRename_index = [...]uint8{0, 1, 2, 3}
+
+func (i IdentRename) String() string {
+	if i < 0 || i >= IdentRename(len(_IdentRename_index)-1) {
+		return "IdentRename(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _IdentRename_name[_IdentRename_index[i]:_IdentRename_index[i+1]]
+}
+
+func _IdentRename_lookup(s string) (IdentRename, bool) {
+	switch len(s) {
+	case 1:
+		switch s[0] {
+		case 'A':
+			if s == _IdentRename_name[0:1] {
+				return IdentRenameA, true
+			}
+		case 'B':
+			if s == _IdentRename_name[1:2] {
+				return IdentRenameB, true
+			}
+		case 'C':
+			if s == _IdentRename_name[2:3] {
+				return IdentRenameC, true
+			}
+		}
+	}
+	return 0, false
+}
+
+var _IdentRename_names = [...]string{
+	_IdentRename_name[0:1],
+	_IdentRename_name[1:2],
+	_IdentRename_name[2:3],
+}
+
+// parseIdentRename returns the IdentRename named s.
+func parseIdentRename(s string) (IdentRename, error) {
+	if i, ok := _IdentRename_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "IdentRename", Input: s, Valid: append([]string(nil), _IdentRename_names[:]...), Err: enum.ErrUnknownName}
+}
+
+// _IdentRename_ordinal returns the position of i among the values of IdentRename, or -1.
+func _IdentRename_ordinal(i IdentRename) int {
+	switch {
+	case i >= 0 && i <= 2:
+		return int(i)
+	}
+	return -1
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i IdentRename) MarshalText() ([]byte, error) {
+	if _IdentRename_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "IdentRename", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *IdentRename) UnmarshalText(b []byte) error {
+	v, err := parseIdentRename(string(b))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// identRenameValues returns the values of IdentRename.
+func identRenameValues() []IdentRename {
+	return []IdentRename{IdentRenameA, IdentRenameB, IdentRenameC}
+}
+
+var _IdentRename_values = [...]IdentRename{0, 1, 2}
+
+// IdentRenameSet is a set of IdentRename values, holding one bit per value.
+type IdentRenameSet struct {
+	bits [1]uint64
+}
+
+// newIdentRenameSet returns the set of the given values.
+func newIdentRenameSet(values ...IdentRename) IdentRenameSet {
+	var s IdentRenameSet
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add adds v to s. Values not defined by IdentRename are ignored.
+func (s *IdentRenameSet) Add(v IdentRename) {
+	if j := _IdentRename_ordinal(v); j >= 0 {
+		s.bits[j/64] |= 1 << (j % 64)
+	}
+}
+
+// Remove removes v from s.
+func (s *IdentRenameSet) Remove(v IdentRename) {
+	if j := _IdentRename_ordinal(v); j >= 0 {
+		s.bits[j/64] &^= 1 << (j % 64)
+	}
+}
+
+// Contains reports whether v is in s.
+func (s IdentRenameSet) Contains(v IdentRename) bool {
+	j := _IdentRename_ordinal(v)
+	return j >= 0 && s.bits[j/64]&(1<<(j%64)) != 0
+}
+
+// Len returns the number of values in s.
+func (s IdentRenameSet) Len() int {
+	n := 0
+	for _, w := range s.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All calls yield with the values in s, in increasing order, until it
+// returns false. With Go 1.23 or later, range over s.All to do so.
+func (s IdentRenameSet) All(yield func(IdentRename) bool) {
+	for k, w := range s.bits {
+		for ; w != 0; w &= w - 1 {
+			if !yield(_IdentRename_values[k*64+bits.TrailingZeros64(w)]) {
+				return
+			}
+		}
+	}
+}
+
+// names returns the names of the values in s.
+func (s IdentRenameSet) names() []string {
+	var names []string
+	s.All(func(v IdentRename) bool {
+		names = append(names, v.String())
+		return true
+	})
+	return names
+}
+
+// String returns the names of the values in s, such as [A B].
+func (s IdentRenameSet) String() string {
+	return "[" + strings.Join(s.names(), " ") + "]"
+}
+
+// MarshalText implements encoding.TextMarshaler, separating names by commas.
+func (s IdentRenameSet) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(s.names(), ",")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *IdentRenameSet) UnmarshalText(b []byte) error {
+	var t IdentRenameSet
+	for _, name := range strings.Split(string(b), ",") {
+		if name == "" {
+			continue
+		}
+		v, err := parseIdentRename(name)
+		if err != nil {
+			return err
+		}
+		t.Add(v)
+	}
+	*s = t
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding s as an array of names.
+func (s IdentRenameSet) MarshalJSON() ([]byte, error) {
+	names := s.names()
+	if names == nil {
+		names = []string{}
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *IdentRenameSet) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	var t IdentRenameSet
+	for _, name := range names {
+		v, err := parseIdentRename(name)
+		if err != nil {
+			return err
+		}
+		t.Add(v)
+	}
+	*s = t
+	return nil
+}
+
+// identRenameCount is the number of values of IdentRename.
+const identRenameCount = 3
+
+// identRenameMap maps each IdentRename value to a V, holding them in an array rather than
+// a Go map. Keys must be values of IdentRename.
+type identRenameMap[V any] struct {
+	values [identRenameCount]V
+}
+
+// Get returns the element of k, or the zero value of V if k is not a value
+// of IdentRename.
+func (m *identRenameMap[V]) Get(k IdentRename) V {
+	if j := uint(k - IdentRenameA); j < identRenameCount {
+		return m.values[j]
+	}
+	var zero V
+	return zero
+}
+
+// Set sets the element of k to v. Keys that are not values of IdentRename are
+// ignored.
+func (m *identRenameMap[V]) Set(k IdentRename, v V) {
+	if j := uint(k - IdentRenameA); j < identRenameCount {
+		m.values[j] = v
+	}
+}
+
+// Range calls f for each key and its element in increasing order of keys,
+// until f returns false.
+func (m *identRenameMap[V]) Range(f func(k IdentRename, v V) bool) {
+	for j, v := range m.values {
+		if !f(IdentRename(j)+IdentRenameA, v) {
+			return
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding m as an object keyed by
+// the names of the keys.
+func (m identRenameMap[V]) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for j, v := range m.values {
+		if j > 0 {
+			b = append(b, ',')
+		}
+		key, err := json.Marshal((IdentRename(j) + IdentRenameA).String())
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		b = append(append(append(b, key...), ':'), value...)
+	}
+	return append(b, '}'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Keys left out keep their
+// elements, and names of no key are an error.
+func (m *identRenameMap[V]) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for name, value := range raw {
+		k, err := parseIdentRename(name)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(value, &m.values[k-IdentRenameA]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package test
+
+type IdentSkip int
+
+const (
+	IdentSkipA IdentSkip = iota
+	IdentSkipB
+	IdentSkipC
+)
+
+// ParseIdentSkip takes the empty string for IdentSkipA.
+func ParseIdentSkip(s string) (IdentSkip, error) {
+	if i, ok := _IdentSkip_lookup(s); ok || s == "" {
+		return i, nil
+	}
+	return 0, ErrIdentSkip
+}
+
+func IdentSkipValues() []IdentSkip { return nil }
+
+var ErrIdentSkip error
+
+type IdentSkipSet []IdentSkip
+
+const IdentSkipCount = 2
@@ -0,0 +1,150 @@
+package test
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[IdentSkipA-0]
+	_ = x[IdentSkipB-1]
+	_ = x[IdentSkipC-2]
+}
+
+const _IdentSkip_name = "ABC"
+
+var _IdentSkip_index = [...]uint8{0, 1, 2, 3}
+
+func (i IdentSkip) String() string {
+	if i < 0 || i >= IdentSkip(len(_IdentSkip_index)-1) {
+		return "IdentSkip(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _IdentSkip_name[_IdentSkip_index[i]:_IdentSkip_index[i+1]]
+}
+
+func _IdentSkip_lookup(s string) (IdentSkip, bool) {
+	switch len(s) {
+	case 1:
+		switch s[0] {
+		case 'A':
+			if s == _IdentSkip_name[0:1] {
+				return IdentSkipA, true
+			}
+		case 'B':
+			if s == _IdentSkip_name[1:2] {
+				return IdentSkipB, true
+			}
+		case 'C':
+			if s == _IdentSkip_name[2:3] {
+				return IdentSkipC, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// _IdentSkip_ordinal returns the position of i among the values of IdentSkip, or -1.
+func _IdentSkip_ordinal(i IdentSkip) int {
+	switch {
+	case i >= 0 && i <= 2:
+		return int(i)
+	}
+	return -1
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i IdentSkip) MarshalText() ([]byte, error) {
+	if _IdentSkip_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "IdentSkip", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *IdentSkip) UnmarshalText(b []byte) error {
+	v, err := ParseIdentSkip(string(b))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// IdentSkipMap maps each IdentSkip value to a V, holding them in an array rather than
+// a Go map. Keys must be values of IdentSkip.
+type IdentSkipMap[V any] struct {
+	values [3]V
+}
+
+// Get returns the element of k, or the zero value of V if k is not a value
+// of IdentSkip.
+func (m *IdentSkipMap[V]) Get(k IdentSkip) V {
+	if j := uint(k - IdentSkipA); j < 3 {
+		return m.values[j]
+	}
+	var zero V
+	return zero
+}
+
+// Set sets the element of k to v. Keys that are not values of IdentSkip are
+// ignored.
+func (m *IdentSkipMap[V]) Set(k IdentSkip, v V) {
+	if j := uint(k - IdentSkipA); j < 3 {
+		m.values[j] = v
+	}
+}
+
+// Range calls f for each key and its element in increasing order of keys,
+// until f returns false.
+func (m *IdentSkipMap[V]) Range(f func(k IdentSkip, v V) bool) {
+	for j, v := range m.values {
+		if !f(IdentSkip(j)+IdentSkipA, v) {
+			return
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding m as an object keyed by
+// the names of the keys.
+func (m IdentSkipMap[V]) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for j, v := range m.values {
+		if j > 0 {
+			b = append(b, ',')
+		}
+		key, err := json.Marshal((IdentSkip(j) + IdentSkipA).String())
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		b = append(append(append(b, key...), ':'), value...)
+	}
+	return append(b, '}'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Keys left out keep their
+// elements, and names of no key are an error.
+func (m *IdentSkipMap[V]) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for name, value := range raw {
+		k, err := ParseIdentSkip(name)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(value, &m.values[k-IdentSkipA]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package test
+
+type Lenient int
+
+const (
+	// LenientUnknown stands for values added after the code was generated.
+	//
+	//stringer:default
+	LenientUnknown Lenient = iota
+	LenientQueued               //stringer:json queued
+	LenientRunning              //stringer:json running
+	LenientDone    Lenient = 10 //stringer:json done
+)
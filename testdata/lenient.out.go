@@ -0,0 +1,163 @@
+package test
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LenientUnknown-0]
+	_ = x[LenientQueued-1]
+	_ = x[LenientRunning-2]
+	_ = x[LenientDone-10]
+}
+
+const (
+	_Lenient_name_0 = "UnknownQueuedRunning"
+	_Lenient_name_1 = "Done"
+)
+
+var (
+	_Lenient_index_0 = [...]uint8{0, 7, 13, 20}
+)
+
+func (i Lenient) String() string {
+	switch {
+	case 0 <= i && i <= 2:
+		return _Lenient_name_0[_Lenient_index_0[i]:_Lenient_index_0[i+1]]
+	case i == 10:
+		return _Lenient_name_1
+	default:
+		return "Unknown"
+	}
+}
+
+func _Lenient_lookup(s string) (Lenient, bool) {
+	switch len(s) {
+	case 4:
+		if s == _Lenient_name_1 {
+			return LenientDone, true
+		}
+	case 6:
+		if s == _Lenient_name_0[7:13] {
+			return LenientQueued, true
+		}
+	case 7:
+		switch s[0] {
+		case 'R':
+			if s == _Lenient_name_0[13:20] {
+				return LenientRunning, true
+			}
+		case 'U':
+			if s == _Lenient_name_0[0:7] {
+				return LenientUnknown, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// ParseLenient returns the Lenient named s.
+// Unknown names stand for LenientUnknown.
+func ParseLenient(s string) (Lenient, error) {
+	if i, ok := _Lenient_lookup(s); ok {
+		return i, nil
+	}
+	return LenientUnknown, nil
+}
+
+// _Lenient_ordinal returns the position of i among the values of Lenient, or -1.
+func _Lenient_ordinal(i Lenient) int {
+	switch {
+	case i >= 0 && i <= 2:
+		return int(i)
+	case i == 10:
+		return 3
+	}
+	return -1
+}
+
+const _Lenient_json_name = "Unknownqueuedrunningdone"
+
+var _Lenient_json_index = [...]uint8{0, 7, 13, 20, 24}
+
+// JSONName returns the name of i in JSON.
+func (i Lenient) JSONName() string {
+	if j := _Lenient_ordinal(i); j >= 0 {
+		return _Lenient_json_name[_Lenient_json_index[j]:_Lenient_json_index[j+1]]
+	}
+	return i.String()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i Lenient) MarshalText() ([]byte, error) {
+	if _Lenient_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "Lenient", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *Lenient) UnmarshalText(b []byte) error {
+	v, err := ParseLenient(string(b))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+func _Lenient_json_lookup(s string) (Lenient, bool) {
+	switch len(s) {
+	case 4:
+		if s == _Lenient_json_name[20:24] {
+			return LenientDone, true
+		}
+	case 6:
+		if s == _Lenient_json_name[7:13] {
+			return LenientQueued, true
+		}
+	case 7:
+		switch s[0] {
+		case 'U':
+			if s == _Lenient_json_name[0:7] {
+				return LenientUnknown, true
+			}
+		case 'r':
+			if s == _Lenient_json_name[13:20] {
+				return LenientRunning, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func _Lenient_json_parse(s string) Lenient {
+	if i, ok := _Lenient_json_lookup(s); ok {
+		return i
+	}
+	return LenientUnknown
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i Lenient) MarshalJSON() ([]byte, error) {
+	if _Lenient_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "Lenient", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return json.Marshal(i.JSONName())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Lenient) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*i = _Lenient_json_parse(s)
+	return nil
+}
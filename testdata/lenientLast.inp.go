@@ -0,0 +1,10 @@
+package test
+
+type LenientLast int
+
+// The default is declared after larger values, so sorting moves it.
+const (
+	LenientLastOK      LenientLast = 1
+	LenientLastFailed  LenientLast = 2 //stringer:json failed
+	LenientLastUnknown LenientLast = 0 //stringer:default
+)
@@ -0,0 +1,137 @@
+package test
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LenientLastOK-1]
+	_ = x[LenientLastFailed-2]
+	_ = x[LenientLastUnknown-0]
+}
+
+const _LenientLast_name = "UnknownOKFailed"
+
+var _LenientLast_index = [...]uint8{0, 7, 9, 15}
+
+func (i LenientLast) String() string {
+	if i < 0 || i >= LenientLast(len(_LenientLast_index)-1) {
+		return "Unknown"
+	}
+	return _LenientLast_name[_LenientLast_index[i]:_LenientLast_index[i+1]]
+}
+
+func _LenientLast_lookup(s string) (LenientLast, bool) {
+	switch len(s) {
+	case 2:
+		if s == _LenientLast_name[7:9] {
+			return LenientLastOK, true
+		}
+	case 6:
+		if s == _LenientLast_name[9:15] {
+			return LenientLastFailed, true
+		}
+	case 7:
+		if s == _LenientLast_name[0:7] {
+			return LenientLastUnknown, true
+		}
+	}
+	return 0, false
+}
+
+// ParseLenientLast returns the LenientLast named s.
+// Unknown names stand for LenientLastUnknown.
+func ParseLenientLast(s string) (LenientLast, error) {
+	if i, ok := _LenientLast_lookup(s); ok {
+		return i, nil
+	}
+	return LenientLastUnknown, nil
+}
+
+// _LenientLast_ordinal returns the position of i among the values of LenientLast, or -1.
+func _LenientLast_ordinal(i LenientLast) int {
+	switch {
+	case i >= 0 && i <= 2:
+		return int(i)
+	}
+	return -1
+}
+
+const _LenientLast_json_name = "UnknownOKfailed"
+
+var _LenientLast_json_index = [...]uint8{0, 7, 9, 15}
+
+// JSONName returns the name of i in JSON.
+func (i LenientLast) JSONName() string {
+	if j := _LenientLast_ordinal(i); j >= 0 {
+		return _LenientLast_json_name[_LenientLast_json_index[j]:_LenientLast_json_index[j+1]]
+	}
+	return i.String()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i LenientLast) MarshalText() ([]byte, error) {
+	if _LenientLast_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "LenientLast", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *LenientLast) UnmarshalText(b []byte) error {
+	v, err := ParseLenientLast(string(b))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+func _LenientLast_json_lookup(s string) (LenientLast, bool) {
+	switch len(s) {
+	case 2:
+		if s == _LenientLast_json_name[7:9] {
+			return LenientLastOK, true
+		}
+	case 6:
+		if s == _LenientLast_json_name[9:15] {
+			return LenientLastFailed, true
+		}
+	case 7:
+		if s == _LenientLast_json_name[0:7] {
+			return LenientLastUnknown, true
+		}
+	}
+	return 0, false
+}
+
+func _LenientLast_json_parse(s string) LenientLast {
+	if i, ok := _LenientLast_json_lookup(s); ok {
+		return i
+	}
+	return LenientLastUnknown
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i LenientLast) MarshalJSON() ([]byte, error) {
+	if _LenientLast_ordinal(i) < 0 {
+		return nil, &enum.ValueError{Type: "LenientLast", Value: strconv.FormatInt(int64(i), 10)}
+	}
+	return json.Marshal(i.JSONName())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *LenientLast) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*i = _LenientLast_json_parse(s)
+	return nil
+}
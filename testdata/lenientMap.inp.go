@@ -0,0 +1,10 @@
+package test
+
+type LenientMap int
+
+const (
+	//stringer:default
+	LenientMapUnknown LenientMap = iota
+	LenientMapOn
+	LenientMapOff
+)
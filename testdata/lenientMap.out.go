@@ -0,0 +1,138 @@
+package test
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LenientMapUnknown-0]
+	_ = x[LenientMapOn-1]
+	_ = x[LenientMapOff-2]
+}
+
+const _LenientMap_name = "UnknownOnOff"
+
+var _LenientMap_index = [...]uint8{0, 7, 9, 12}
+
+func (i LenientMap) String() string {
+	if i < 0 || i >= LenientMap(len(_LenientMap_index)-1) {
+		return "LenientMap(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _LenientMap_name[_LenientMap_index[i]:_LenientMap_index[i+1]]
+}
+
+func _LenientMap_lookup(s string) (LenientMap, bool) {
+	switch len(s) {
+	case 2:
+		if s == _LenientMap_name[7:9] {
+			return LenientMapOn, true
+		}
+	case 3:
+		if s == _LenientMap_name[9:12] {
+			return LenientMapOff, true
+		}
+	case 7:
+		if s == _LenientMap_name[0:7] {
+			return LenientMapUnknown, true
+		}
+	}
+	return 0, false
+}
+
+var _LenientMap_names = [...]string{
+	_LenientMap_name[0:7],
+	_LenientMap_name[7:9],
+	_LenientMap_name[9:12],
+}
+
+// ParseLenientMap returns the LenientMap named s.
+// Unknown names stand for LenientMapUnknown.
+func ParseLenientMap(s string) (LenientMap, error) {
+	if i, ok := _LenientMap_lookup(s); ok {
+		return i, nil
+	}
+	return LenientMapUnknown, nil
+}
+
+// LenientMapCount is the number of values of LenientMap.
+const LenientMapCount = 3
+
+// LenientMapMap maps each LenientMap value to a V, holding them in an array rather than
+// a Go map. Keys must be values of LenientMap.
+type LenientMapMap[V any] struct {
+	values [LenientMapCount]V
+}
+
+// Get returns the element of k, or the zero value of V if k is not a value
+// of LenientMap.
+func (m *LenientMapMap[V]) Get(k LenientMap) V {
+	if j := uint(k - LenientMapUnknown); j < LenientMapCount {
+		return m.values[j]
+	}
+	var zero V
+	return zero
+}
+
+// Set sets the element of k to v. Keys that are not values of LenientMap are
+// ignored.
+func (m *LenientMapMap[V]) Set(k LenientMap, v V) {
+	if j := uint(k - LenientMapUnknown); j < LenientMapCount {
+		m.values[j] = v
+	}
+}
+
+// Range calls f for each key and its element in increasing order of keys,
+// until f returns false.
+func (m *LenientMapMap[V]) Range(f func(k LenientMap, v V) bool) {
+	for j, v := range m.values {
+		if !f(LenientMap(j)+LenientMapUnknown, v) {
+			return
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding m as an object keyed by
+// the names of the keys.
+func (m LenientMapMap[V]) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for j, v := range m.values {
+		if j > 0 {
+			b = append(b, ',')
+		}
+		key, err := json.Marshal((LenientMap(j) + LenientMapUnknown).String())
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		b = append(append(append(b, key...), ':'), value...)
+	}
+	return append(b, '}'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Keys left out keep their
+// elements, and names of no key are an error.
+func (m *LenientMapMap[V]) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for name, value := range raw {
+		k, ok := _LenientMap_lookup(name)
+		if !ok {
+			return &enum.ParseError{Type: "LenientMap", Input: name, Valid: append([]string(nil), _LenientMap_names[:]...), Err: enum.ErrUnknownName}
+		}
+		if err := json.Unmarshal(value, &m.values[k-LenientMapUnknown]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
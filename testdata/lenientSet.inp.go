@@ -0,0 +1,10 @@
+package test
+
+type LenientSet int
+
+const (
+	//stringer:default
+	LenientSetUnknown LenientSet = iota
+	LenientSetDebug
+	LenientSetInfo
+)
@@ -0,0 +1,193 @@
+package test
+
+import (
+	"encoding/json"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LenientSetUnknown-0]
+	_ = x[LenientSetDebug-1]
+	_ = x[LenientSetInfo-2]
+}
+
+const _LenientSet_name = "UnknownDebugInfo"
+
+var _LenientSet_index = [...]uint8{0, 7, 12, 16}
+
+func (i LenientSet) String() string {
+	if i < 0 || i >= LenientSet(len(_LenientSet_index)-1) {
+		return "LenientSet(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _LenientSet_name[_LenientSet_index[i]:_LenientSet_index[i+1]]
+}
+
+func _LenientSet_lookup(s string) (LenientSet, bool) {
+	switch len(s) {
+	case 4:
+		if s == _LenientSet_name[12:16] {
+			return LenientSetInfo, true
+		}
+	case 5:
+		if s == _LenientSet_name[7:12] {
+			return LenientSetDebug, true
+		}
+	case 7:
+		if s == _LenientSet_name[0:7] {
+			return LenientSetUnknown, true
+		}
+	}
+	return 0, false
+}
+
+var _LenientSet_names = [...]string{
+	_LenientSet_name[0:7],
+	_LenientSet_name[7:12],
+	_LenientSet_name[12:16],
+}
+
+// ParseLenientSet returns the LenientSet named s.
+// Unknown names stand for LenientSetUnknown.
+func ParseLenientSet(s string) (LenientSet, error) {
+	if i, ok := _LenientSet_lookup(s); ok {
+		return i, nil
+	}
+	return LenientSetUnknown, nil
+}
+
+// _LenientSet_ordinal returns the position of i among the values of LenientSet, or -1.
+func _LenientSet_ordinal(i LenientSet) int {
+	switch {
+	case i >= 0 && i <= 2:
+		return int(i)
+	}
+	return -1
+}
+
+var _LenientSet_values = [...]LenientSet{0, 1, 2}
+
+// LenientSetSet is a set of LenientSet values, holding one bit per value.
+type LenientSetSet struct {
+	bits [1]uint64
+}
+
+// NewLenientSetSet returns the set of the given values.
+func NewLenientSetSet(values ...LenientSet) LenientSetSet {
+	var s LenientSetSet
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add adds v to s. Values not defined by LenientSet are ignored.
+func (s *LenientSetSet) Add(v LenientSet) {
+	if j := _LenientSet_ordinal(v); j >= 0 {
+		s.bits[j/64] |= 1 << (j % 64)
+	}
+}
+
+// Remove removes v from s.
+func (s *LenientSetSet) Remove(v LenientSet) {
+	if j := _LenientSet_ordinal(v); j >= 0 {
+		s.bits[j/64] &^= 1 << (j % 64)
+	}
+}
+
+// Contains reports whether v is in s.
+func (s LenientSetSet) Contains(v LenientSet) bool {
+	j := _LenientSet_ordinal(v)
+	return j >= 0 && s.bits[j/64]&(1<<(j%64)) != 0
+}
+
+// Len returns the number of values in s.
+func (s LenientSetSet) Len() int {
+	n := 0
+	for _, w := range s.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All calls yield with the values in s, in increasing order, until it
+// returns false. With Go 1.23 or later, range over s.All to do so.
+func (s LenientSetSet) All(yield func(LenientSet) bool) {
+	for k, w := range s.bits {
+		for ; w != 0; w &= w - 1 {
+			if !yield(_LenientSet_values[k*64+bits.TrailingZeros64(w)]) {
+				return
+			}
+		}
+	}
+}
+
+// names returns the names of the values in s.
+func (s LenientSetSet) names() []string {
+	var names []string
+	s.All(func(v LenientSet) bool {
+		names = append(names, v.String())
+		return true
+	})
+	return names
+}
+
+// String returns the names of the values in s, such as [A B].
+func (s LenientSetSet) String() string {
+	return "[" + strings.Join(s.names(), " ") + "]"
+}
+
+// MarshalText implements encoding.TextMarshaler, separating names by commas.
+func (s LenientSetSet) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(s.names(), ",")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LenientSetSet) UnmarshalText(b []byte) error {
+	var t LenientSetSet
+	for _, name := range strings.Split(string(b), ",") {
+		if name == "" {
+			continue
+		}
+		v, ok := _LenientSet_lookup(name)
+		if !ok {
+			return &enum.ParseError{Type: "LenientSet", Input: name, Valid: append([]string(nil), _LenientSet_names[:]...), Err: enum.ErrUnknownName}
+		}
+		t.Add(v)
+	}
+	*s = t
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding s as an array of names.
+func (s LenientSetSet) MarshalJSON() ([]byte, error) {
+	names := s.names()
+	if names == nil {
+		names = []string{}
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *LenientSetSet) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	var t LenientSetSet
+	for _, name := range names {
+		v, ok := _LenientSet_lookup(name)
+		if !ok {
+			return &enum.ParseError{Type: "LenientSet", Input: name, Valid: append([]string(nil), _LenientSet_names[:]...), Err: enum.ErrUnknownName}
+		}
+		t.Add(v)
+	}
+	*s = t
+	return nil
+}
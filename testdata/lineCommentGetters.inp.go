@@ -0,0 +1,9 @@
+package test
+
+type LineCommentGetters uint8
+
+const (
+	LineCommentGettersRead  LineCommentGetters = 1 << iota // Readable
+	LineCommentGettersWrite                                // Writable
+	LineCommentGettersExec
+)
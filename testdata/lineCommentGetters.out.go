@@ -0,0 +1,96 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LineCommentGettersRead-1]
+	_ = x[LineCommentGettersWrite-2]
+	_ = x[LineCommentGettersExec-4]
+}
+
+const (
+	_LineCommentGetters_name_0 = "ReadableWritableExec"
+)
+
+var (
+	_LineCommentGetters_index_0 = [...]uint8{0, 8, 16, 20}
+)
+
+func (i LineCommentGetters) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _LineCommentGetters_name_0[_LineCommentGetters_index_0[0]:_LineCommentGetters_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _LineCommentGetters_name_0[_LineCommentGetters_index_0[1]:_LineCommentGetters_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _LineCommentGetters_name_0[_LineCommentGetters_index_0[2]:_LineCommentGetters_index_0[3]])
+	}
+	if i != 0 {
+		s = append(s, "LineCommentGetters("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i LineCommentGetters) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i LineCommentGetters) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _LineCommentGetters_name_0[_LineCommentGetters_index_0[0]:_LineCommentGetters_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _LineCommentGetters_name_0[_LineCommentGetters_index_0[1]:_LineCommentGetters_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _LineCommentGetters_name_0[_LineCommentGetters_index_0[2]:_LineCommentGetters_index_0[3]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "LineCommentGetters("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i LineCommentGetters) String() string {
+	var buf [64]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func (i LineCommentGetters) Readable() bool {
+	return i&LineCommentGettersRead == LineCommentGettersRead
+}
+func (i LineCommentGetters) SetReadable() LineCommentGetters   { return i | LineCommentGettersRead }
+func (i LineCommentGetters) ClearReadable() LineCommentGetters { return i & ^LineCommentGettersRead }
+
+func (i LineCommentGetters) Writable() bool {
+	return i&LineCommentGettersWrite == LineCommentGettersWrite
+}
+func (i LineCommentGetters) SetWritable() LineCommentGetters   { return i | LineCommentGettersWrite }
+func (i LineCommentGetters) ClearWritable() LineCommentGetters { return i & ^LineCommentGettersWrite }
+
+func (i LineCommentGetters) Exec() bool                    { return i&LineCommentGettersExec == LineCommentGettersExec }
+func (i LineCommentGetters) SetExec() LineCommentGetters   { return i | LineCommentGettersExec }
+func (i LineCommentGetters) ClearExec() LineCommentGetters { return i & ^LineCommentGettersExec }
@@ -0,0 +1,9 @@
+package test
+
+type Logging int
+
+const (
+	LoggingDebug Logging = iota - 1
+	LoggingInfo
+	LoggingWarn
+)
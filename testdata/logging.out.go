@@ -0,0 +1,51 @@
+package test
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LoggingDebug - -1]
+	_ = x[LoggingInfo-0]
+	_ = x[LoggingWarn-1]
+}
+
+const _Logging_name = "DebugInfoWarn"
+
+var _Logging_index = [...]uint8{0, 5, 9, 13}
+
+func (i Logging) String() string {
+	i -= -1
+	if i < 0 || i >= Logging(len(_Logging_index)-1) {
+		return "Logging(" + strconv.FormatInt(int64(i+-1), 10) + ")"
+	}
+	return _Logging_name[_Logging_index[i]:_Logging_index[i+1]]
+}
+
+// LogValue implements slog.LogValuer.
+func (i Logging) LogValue() slog.Value {
+	return slog.StringValue(i.String())
+}
+
+// Format implements fmt.Formatter: the verbs b, d, o, O, x and X print the
+// number of i, %+v its name followed by the number in parentheses, and the
+// other verbs its name.
+func (i Logging) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'b', 'd', 'o', 'O', 'x', 'X':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(i))
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "%s(%d)", i.String(), int64(i))
+			return
+		}
+		fallthrough
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), i.String())
+	}
+}
@@ -0,0 +1,9 @@
+package test
+
+type LoggingFlags uint8
+
+const (
+	LoggingFlagsRead LoggingFlags = 1 << iota
+	LoggingFlagsWrite
+	LoggingFlagsExec
+)
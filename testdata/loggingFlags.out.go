@@ -0,0 +1,108 @@
+package test
+
+import (
+	"fmt"
+	"log/slog"
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LoggingFlagsRead-1]
+	_ = x[LoggingFlagsWrite-2]
+	_ = x[LoggingFlagsExec-4]
+}
+
+const (
+	_LoggingFlags_name_0 = "ReadWriteExec"
+)
+
+var (
+	_LoggingFlags_index_0 = [...]uint8{0, 4, 9, 13}
+)
+
+func (i LoggingFlags) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _LoggingFlags_name_0[_LoggingFlags_index_0[0]:_LoggingFlags_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _LoggingFlags_name_0[_LoggingFlags_index_0[1]:_LoggingFlags_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _LoggingFlags_name_0[_LoggingFlags_index_0[2]:_LoggingFlags_index_0[3]])
+	}
+	if i != 0 {
+		s = append(s, "LoggingFlags("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i LoggingFlags) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i LoggingFlags) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _LoggingFlags_name_0[_LoggingFlags_index_0[0]:_LoggingFlags_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _LoggingFlags_name_0[_LoggingFlags_index_0[1]:_LoggingFlags_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _LoggingFlags_name_0[_LoggingFlags_index_0[2]:_LoggingFlags_index_0[3]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "LoggingFlags("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i LoggingFlags) String() string {
+	var buf [51]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+// LogValue implements slog.LogValuer.
+func (i LoggingFlags) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("flags", i.ActiveFlags()),
+		slog.Uint64("value", uint64(i)),
+	)
+}
+
+// Format implements fmt.Formatter: the verbs b, d, o, O, x and X print the
+// number of i, %+v its name followed by the number in parentheses, and the
+// other verbs its name.
+func (i LoggingFlags) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'b', 'd', 'o', 'O', 'x', 'X':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), uint64(i))
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "%s(%#x)", i.String(), uint64(i))
+			return
+		}
+		fallthrough
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), i.String())
+	}
+}
@@ -0,0 +1,8 @@
+package test
+
+type LoggingSigned int16
+
+const (
+	LoggingSignedRead LoggingSigned = 1 << iota
+	LoggingSignedWrite
+)
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"log/slog"
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LoggingSignedRead-1]
+	_ = x[LoggingSignedWrite-2]
+}
+
+const (
+	_LoggingSigned_name_0 = "ReadWrite"
+)
+
+var (
+	_LoggingSigned_index_0 = [...]uint8{0, 4, 9}
+)
+
+func (i LoggingSigned) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _LoggingSigned_name_0[_LoggingSigned_index_0[0]:_LoggingSigned_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _LoggingSigned_name_0[_LoggingSigned_index_0[1]:_LoggingSigned_index_0[2]])
+	}
+	if i != 0 {
+		s = append(s, "LoggingSigned("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i LoggingSigned) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount16(uint16(i))))
+}
+
+func (i LoggingSigned) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _LoggingSigned_name_0[_LoggingSigned_index_0[0]:_LoggingSigned_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _LoggingSigned_name_0[_LoggingSigned_index_0[1]:_LoggingSigned_index_0[2]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "LoggingSigned("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i LoggingSigned) String() string {
+	var buf [47]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+// LogValue implements slog.LogValuer.
+func (i LoggingSigned) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("flags", i.ActiveFlags()),
+		slog.Int64("value", int64(i)),
+	)
+}
@@ -0,0 +1,27 @@
+package test
+
+type Map int
+
+const (
+	MapContinue  Map = 100
+	MapSwitching Map = 101
+
+	MapOK        Map = 200
+	MapCreated   Map = 201
+	MapAccepted  Map = 202
+	MapNoContent Map = 204
+
+	MapMoved       Map = 301
+	MapFound       Map = 302
+	MapNotModified Map = 304
+
+	MapBadRequest   Map = 400
+	MapUnauthorized Map = 401
+	MapForbidden    Map = 403
+	MapNotFound     Map = 404
+	MapConflict     Map = 409
+
+	MapInternal    Map = 500
+	MapBadGateway  Map = 502
+	MapUnavailable Map = 503
+)
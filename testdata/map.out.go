@@ -0,0 +1,57 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MapContinue-100]
+	_ = x[MapSwitching-101]
+	_ = x[MapOK-200]
+	_ = x[MapCreated-201]
+	_ = x[MapAccepted-202]
+	_ = x[MapNoContent-204]
+	_ = x[MapMoved-301]
+	_ = x[MapFound-302]
+	_ = x[MapNotModified-304]
+	_ = x[MapBadRequest-400]
+	_ = x[MapUnauthorized-401]
+	_ = x[MapForbidden-403]
+	_ = x[MapNotFound-404]
+	_ = x[MapConflict-409]
+	_ = x[MapInternal-500]
+	_ = x[MapBadGateway-502]
+	_ = x[MapUnavailable-503]
+}
+
+const _Map_name = "ContinueSwitchingOKCreatedAcceptedNoContentMovedFoundNotModifiedBadRequestUnauthorizedForbiddenNotFoundConflictInternalBadGatewayUnavailable"
+
+var _Map_map = map[Map]string{
+	100: _Map_name[0:8],
+	101: _Map_name[8:17],
+	200: _Map_name[17:19],
+	201: _Map_name[19:26],
+	202: _Map_name[26:34],
+	204: _Map_name[34:43],
+	301: _Map_name[43:48],
+	302: _Map_name[48:53],
+	304: _Map_name[53:64],
+	400: _Map_name[64:74],
+	401: _Map_name[74:86],
+	403: _Map_name[86:95],
+	404: _Map_name[95:103],
+	409: _Map_name[103:111],
+	500: _Map_name[111:119],
+	502: _Map_name[119:129],
+	503: _Map_name[129:140],
+}
+
+func (i Map) String() string {
+	if str, ok := _Map_map[i]; ok {
+		return str
+	}
+	return "Map(" + strconv.FormatInt(int64(i), 10) + ")"
+}
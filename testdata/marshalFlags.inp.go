@@ -0,0 +1,9 @@
+package test
+
+type MarshalFlags uint8
+
+const (
+	MarshalFlagsRead MarshalFlags = 1 << iota
+	MarshalFlagsWrite
+	MarshalFlagsExec
+)
@@ -0,0 +1,168 @@
+package test
+
+import (
+	"encoding/json"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MarshalFlagsRead-1]
+	_ = x[MarshalFlagsWrite-2]
+	_ = x[MarshalFlagsExec-4]
+}
+
+const (
+	_MarshalFlags_name_0 = "ReadWriteExec"
+)
+
+var (
+	_MarshalFlags_index_0 = [...]uint8{0, 4, 9, 13}
+)
+
+func (i MarshalFlags) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _MarshalFlags_name_0[_MarshalFlags_index_0[0]:_MarshalFlags_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _MarshalFlags_name_0[_MarshalFlags_index_0[1]:_MarshalFlags_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _MarshalFlags_name_0[_MarshalFlags_index_0[2]:_MarshalFlags_index_0[3]])
+	}
+	if i != 0 {
+		s = append(s, "MarshalFlags("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i MarshalFlags) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i MarshalFlags) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _MarshalFlags_name_0[_MarshalFlags_index_0[0]:_MarshalFlags_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _MarshalFlags_name_0[_MarshalFlags_index_0[1]:_MarshalFlags_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _MarshalFlags_name_0[_MarshalFlags_index_0[2]:_MarshalFlags_index_0[3]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "MarshalFlags("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i MarshalFlags) String() string {
+	var buf [51]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func _MarshalFlags_lookup(s string) (MarshalFlags, bool) {
+	switch len(s) {
+	case 4:
+		switch s[0] {
+		case 'E':
+			if s == _MarshalFlags_name_0[9:13] {
+				return MarshalFlagsExec, true
+			}
+		case 'R':
+			if s == _MarshalFlags_name_0[0:4] {
+				return MarshalFlagsRead, true
+			}
+		}
+	case 5:
+		if s == _MarshalFlags_name_0[4:9] {
+			return MarshalFlagsWrite, true
+		}
+	}
+	return 0, false
+}
+
+var _MarshalFlags_names = [...]string{
+	_MarshalFlags_name_0[0:4],
+	_MarshalFlags_name_0[4:9],
+	_MarshalFlags_name_0[9:13],
+}
+
+// ParseMarshalFlags returns the MarshalFlags made of the flags named in s, separated by
+// '+'. The empty string stands for no flags.
+func ParseMarshalFlags(s string) (MarshalFlags, error) {
+	var i MarshalFlags
+	for s != "" {
+		name, rest, _ := strings.Cut(s, "+")
+		f, ok := _MarshalFlags_lookup(name)
+		if !ok {
+			return 0, &enum.ParseError{Type: "MarshalFlags", Input: name, Valid: append([]string(nil), _MarshalFlags_names[:]...), Err: enum.ErrUnknownName}
+		}
+		i, s = i|f, rest
+	}
+	return i, nil
+}
+
+const _MarshalFlags_mask MarshalFlags = 0x7
+
+// MarshalText implements encoding.TextMarshaler.
+func (i MarshalFlags) MarshalText() ([]byte, error) {
+	if i&^_MarshalFlags_mask != 0 {
+		return nil, &enum.ValueError{Type: "MarshalFlags", Value: strconv.FormatUint(uint64(i), 10)}
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *MarshalFlags) UnmarshalText(b []byte) error {
+	v, err := ParseMarshalFlags(string(b))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i MarshalFlags) MarshalJSON() ([]byte, error) {
+	if i&^_MarshalFlags_mask != 0 {
+		return nil, &enum.ValueError{Type: "MarshalFlags", Value: strconv.FormatUint(uint64(i), 10)}
+	}
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *MarshalFlags) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := ParseMarshalFlags(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
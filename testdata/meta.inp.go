@@ -0,0 +1,15 @@
+package test
+
+type Meta int
+
+const (
+	MetaBadRequest   Meta = iota + 400 //stringer:meta category=client retryable=false httpStatus=400
+	MetaUnauthorized                   //stringer:meta category=client httpStatus=401 hint="log in first"
+
+	// MetaUnavailable may go away by itself.
+	//
+	//stringer:meta category=server retryable=true httpStatus=503
+	MetaUnavailable Meta = 503
+
+	MetaUnknown Meta = 999
+)
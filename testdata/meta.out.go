@@ -0,0 +1,96 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MetaBadRequest-400]
+	_ = x[MetaUnauthorized-401]
+	_ = x[MetaUnavailable-503]
+	_ = x[MetaUnknown-999]
+}
+
+const (
+	_Meta_name_0 = "BadRequestUnauthorized"
+	_Meta_name_1 = "Unavailable"
+	_Meta_name_2 = "Unknown"
+)
+
+var (
+	_Meta_index_0 = [...]uint8{0, 10, 22}
+)
+
+func (i Meta) String() string {
+	switch {
+	case 400 <= i && i <= 401:
+		i -= 400
+		return _Meta_name_0[_Meta_index_0[i]:_Meta_index_0[i+1]]
+	case i == 503:
+		return _Meta_name_1
+	case i == 999:
+		return _Meta_name_2
+	default:
+		return "Meta(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// _Meta_ordinal returns the position of i among the values of Meta, or -1.
+func _Meta_ordinal(i Meta) int {
+	switch {
+	case i >= 400 && i <= 401:
+		return int(i - 400)
+	case i == 503:
+		return 2
+	case i == 999:
+		return 3
+	}
+	return -1
+}
+
+const _Meta_meta_category = "clientclientserver"
+
+var _Meta_meta_category_index = [...]uint8{0, 6, 12, 18, 18}
+
+// Category returns the category metadata of i, or "" for values without it.
+func (i Meta) Category() string {
+	if j := _Meta_ordinal(i); j >= 0 {
+		return _Meta_meta_category[_Meta_meta_category_index[j]:_Meta_meta_category_index[j+1]]
+	}
+	return ""
+}
+
+const _Meta_meta_hint = "log in first"
+
+var _Meta_meta_hint_index = [...]uint8{0, 0, 12, 12, 12}
+
+// Hint returns the hint metadata of i, or "" for values without it.
+func (i Meta) Hint() string {
+	if j := _Meta_ordinal(i); j >= 0 {
+		return _Meta_meta_hint[_Meta_meta_hint_index[j]:_Meta_meta_hint_index[j+1]]
+	}
+	return ""
+}
+
+var _Meta_meta_httpStatus = [...]int{400, 401, 503, 0}
+
+// HTTPStatus returns the httpStatus metadata of i, or 0 for values without it.
+func (i Meta) HTTPStatus() int {
+	if j := _Meta_ordinal(i); j >= 0 {
+		return _Meta_meta_httpStatus[j]
+	}
+	return 0
+}
+
+var _Meta_meta_retryable = [...]bool{false, false, true, false}
+
+// Retryable returns the retryable metadata of i, or false for values without it.
+func (i Meta) Retryable() bool {
+	if j := _Meta_ordinal(i); j >= 0 {
+		return _Meta_meta_retryable[j]
+	}
+	return false
+}
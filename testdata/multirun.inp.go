@@ -0,0 +1,17 @@
+package test
+
+type Multirun uint32
+
+const (
+	A Multirun = 1 << 0
+	B Multirun = 1 << 1
+	C Multirun = 1 << 2
+
+	D Multirun = 1 << 8
+	E Multirun = 1 << 9
+
+	F Multirun = 1 << 16
+
+	G Multirun = 1 << 24
+	H Multirun = 1 << 25
+)
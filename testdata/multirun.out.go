@@ -0,0 +1,135 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[A-1]
+	_ = x[B-2]
+	_ = x[C-4]
+	_ = x[D-256]
+	_ = x[E-512]
+	_ = x[F-65536]
+	_ = x[G-16777216]
+	_ = x[H-33554432]
+}
+
+const (
+	_Multirun_name_0 = "ABC"
+	_Multirun_name_1 = "DE"
+	_Multirun_name_2 = "F"
+	_Multirun_name_3 = "GH"
+)
+
+var (
+	_Multirun_index_0 = [...]uint8{0, 1, 2, 3}
+	_Multirun_index_1 = [...]uint8{0, 1, 2}
+	_Multirun_index_3 = [...]uint8{0, 1, 2}
+)
+
+func (i Multirun) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _Multirun_name_0[_Multirun_index_0[0]:_Multirun_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Multirun_name_0[_Multirun_index_0[1]:_Multirun_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Multirun_name_0[_Multirun_index_0[2]:_Multirun_index_0[3]])
+	}
+	if i&256 != 0 {
+		i, s = i&^256, append(s, _Multirun_name_1[_Multirun_index_1[0]:_Multirun_index_1[1]])
+	}
+	if i&512 != 0 {
+		i, s = i&^512, append(s, _Multirun_name_1[_Multirun_index_1[1]:_Multirun_index_1[2]])
+	}
+	if i&65536 != 0 {
+		i, s = i&^65536, append(s, _Multirun_name_2)
+	}
+	if i&16777216 != 0 {
+		i, s = i&^16777216, append(s, _Multirun_name_3[_Multirun_index_3[0]:_Multirun_index_3[1]])
+	}
+	if i&33554432 != 0 {
+		i, s = i&^33554432, append(s, _Multirun_name_3[_Multirun_index_3[1]:_Multirun_index_3[2]])
+	}
+	if i != 0 {
+		s = append(s, "Multirun("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Multirun) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount32(uint32(i))))
+}
+
+func (i Multirun) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _Multirun_name_0[_Multirun_index_0[0]:_Multirun_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Multirun_name_0[_Multirun_index_0[1]:_Multirun_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Multirun_name_0[_Multirun_index_0[2]:_Multirun_index_0[3]]...)
+	}
+	if i&256 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^256, append(b, _Multirun_name_1[_Multirun_index_1[0]:_Multirun_index_1[1]]...)
+	}
+	if i&512 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^512, append(b, _Multirun_name_1[_Multirun_index_1[1]:_Multirun_index_1[2]]...)
+	}
+	if i&65536 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^65536, append(b, _Multirun_name_2...)
+	}
+	if i&16777216 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^16777216, append(b, _Multirun_name_3[_Multirun_index_3[0]:_Multirun_index_3[1]]...)
+	}
+	if i&33554432 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^33554432, append(b, _Multirun_name_3[_Multirun_index_3[1]:_Multirun_index_3[2]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Multirun("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Multirun) String() string {
+	var buf [47]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
@@ -0,0 +1,12 @@
+package test
+
+type Navigation int
+
+const (
+	NavigationTrace Navigation = iota - 1
+	NavigationDebug
+	NavigationInfo
+	NavigationNotice            = NavigationInfo
+	NavigationWarn   Navigation = 4
+	NavigationError  Navigation = 8
+)
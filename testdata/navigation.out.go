@@ -0,0 +1,86 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[NavigationTrace - -1]
+	_ = x[NavigationDebug-0]
+	_ = x[NavigationInfo-1]
+	_ = x[NavigationWarn-4]
+	_ = x[NavigationError-8]
+}
+
+const (
+	_Navigation_name_0 = "TraceDebugInfo"
+	_Navigation_name_1 = "Warn"
+	_Navigation_name_2 = "Error"
+)
+
+var (
+	_Navigation_index_0 = [...]uint8{0, 5, 10, 14}
+)
+
+func (i Navigation) String() string {
+	switch {
+	case -1 <= i && i <= 1:
+		i -= -1
+		return _Navigation_name_0[_Navigation_index_0[i]:_Navigation_index_0[i+1]]
+	case i == 4:
+		return _Navigation_name_1
+	case i == 8:
+		return _Navigation_name_2
+	default:
+		return "Navigation(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+const (
+	NavigationFirst Navigation = NavigationTrace // The smallest value of Navigation.
+	NavigationLast  Navigation = NavigationError // The largest value of Navigation.
+)
+
+// _Navigation_ordinal returns the position of i among the values of Navigation, or -1.
+func _Navigation_ordinal(i Navigation) int {
+	switch {
+	case i >= -1 && i <= 1:
+		return int(i - -1)
+	case i == 4:
+		return 3
+	case i == 8:
+		return 4
+	}
+	return -1
+}
+
+// Ordinal returns the position of i among the values of Navigation, starting at 0,
+// or -1 if i is not one of them.
+func (i Navigation) Ordinal() int {
+	return _Navigation_ordinal(i)
+}
+
+var _Navigation_values = [...]Navigation{-1, 0, 1, 4, 8}
+
+// Next returns the value following i, or i and false if i is the last
+// value of Navigation or not one of them.
+func (i Navigation) Next() (Navigation, bool) {
+	j := _Navigation_ordinal(i)
+	if j < 0 || j+1 == len(_Navigation_values) {
+		return i, false
+	}
+	return _Navigation_values[j+1], true
+}
+
+// Prev returns the value preceding i, or i and false if i is the first
+// value of Navigation or not one of them.
+func (i Navigation) Prev() (Navigation, bool) {
+	j := _Navigation_ordinal(i)
+	if j <= 0 {
+		return i, false
+	}
+	return _Navigation_values[j-1], true
+}
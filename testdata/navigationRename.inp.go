@@ -0,0 +1,12 @@
+package test
+
+type NavigationRename int
+
+const (
+	NavigationRenameA NavigationRename = iota
+	NavigationRenameB
+	NavigationRenameC
+)
+
+// NavigationRenameLast is the last value supported by the first version.
+const NavigationRenameLast = NavigationRenameB
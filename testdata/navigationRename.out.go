@@ -0,0 +1,67 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[NavigationRenameA-0]
+	_ = x[NavigationRenameB-1]
+	_ = x[NavigationRenameC-2]
+}
+
+const _NavigationRename_name = "ABC"
+
+var _NavigationRename_index = [...]uint8{0, 1, 2, 3}
+
+func (i NavigationRename) String() string {
+	if i < 0 || i >= NavigationRename(len(_NavigationRename_index)-1) {
+		return "NavigationRename(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _NavigationRename_name[_NavigationRename_index[i]:_NavigationRename_index[i+1]]
+}
+
+const (
+	NavigationRenameFirst NavigationRename = NavigationRenameA // The smallest value of NavigationRename.
+	navigationRenameLast  NavigationRename = NavigationRenameC // The largest value of NavigationRename.
+)
+
+// _NavigationRename_ordinal returns the position of i among the values of NavigationRename, or -1.
+func _NavigationRename_ordinal(i NavigationRename) int {
+	switch {
+	case i >= 0 && i <= 2:
+		return int(i)
+	}
+	return -1
+}
+
+// Ordinal returns the position of i among the values of NavigationRename, starting at 0,
+// or -1 if i is not one of them.
+func (i NavigationRename) Ordinal() int {
+	return _NavigationRename_ordinal(i)
+}
+
+var _NavigationRename_values = [...]NavigationRename{0, 1, 2}
+
+// Next returns the value following i, or i and false if i is the last
+// value of NavigationRename or not one of them.
+func (i NavigationRename) Next() (NavigationRename, bool) {
+	j := _NavigationRename_ordinal(i)
+	if j < 0 || j+1 == len(_NavigationRename_values) {
+		return i, false
+	}
+	return _NavigationRename_values[j+1], true
+}
+
+// Prev returns the value preceding i, or i and false if i is the first
+// value of NavigationRename or not one of them.
+func (i NavigationRename) Prev() (NavigationRename, bool) {
+	j := _NavigationRename_ordinal(i)
+	if j <= 0 {
+		return i, false
+	}
+	return _NavigationRename_values[j-1], true
+}
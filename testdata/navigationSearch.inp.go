@@ -0,0 +1,9 @@
+package test
+
+type NavigationSearch uint
+
+const (
+	NavigationSearchLow NavigationSearch = iota + 1
+	NavigationSearchMid
+	NavigationSearchHigh NavigationSearch = 100
+)
@@ -0,0 +1,84 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[NavigationSearchLow-1]
+	_ = x[NavigationSearchMid-2]
+	_ = x[NavigationSearchHigh-100]
+}
+
+const _NavigationSearch_name = "LowMidHigh"
+
+var _NavigationSearch_values = [...]NavigationSearch{1, 2, 100}
+
+var _NavigationSearch_index = [...]uint8{0, 3, 6, 10}
+
+func (i NavigationSearch) String() string {
+	lo, hi := 0, len(_NavigationSearch_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _NavigationSearch_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_NavigationSearch_values) && _NavigationSearch_values[lo] == i {
+		return _NavigationSearch_name[_NavigationSearch_index[lo]:_NavigationSearch_index[lo+1]]
+	}
+	return "NavigationSearch(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+const (
+	NavigationSearchFirst NavigationSearch = NavigationSearchLow  // The smallest value of NavigationSearch.
+	NavigationSearchLast  NavigationSearch = NavigationSearchHigh // The largest value of NavigationSearch.
+)
+
+// _NavigationSearch_ordinal returns the position of i among the values of NavigationSearch, or -1.
+func _NavigationSearch_ordinal(i NavigationSearch) int {
+	lo, hi := 0, len(_NavigationSearch_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _NavigationSearch_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_NavigationSearch_values) && _NavigationSearch_values[lo] == i {
+		return lo
+	}
+	return -1
+}
+
+// Ordinal returns the position of i among the values of NavigationSearch, starting at 0,
+// or -1 if i is not one of them.
+func (i NavigationSearch) Ordinal() int {
+	return _NavigationSearch_ordinal(i)
+}
+
+// Next returns the value following i, or i and false if i is the last
+// value of NavigationSearch or not one of them.
+func (i NavigationSearch) Next() (NavigationSearch, bool) {
+	j := _NavigationSearch_ordinal(i)
+	if j < 0 || j+1 == len(_NavigationSearch_values) {
+		return i, false
+	}
+	return _NavigationSearch_values[j+1], true
+}
+
+// Prev returns the value preceding i, or i and false if i is the first
+// value of NavigationSearch or not one of them.
+func (i NavigationSearch) Prev() (NavigationSearch, bool) {
+	j := _NavigationSearch_ordinal(i)
+	if j <= 0 {
+		return i, false
+	}
+	return _NavigationSearch_values[j-1], true
+}
@@ -0,0 +1,15 @@
+package test
+
+type Parse int
+
+const (
+	ParseRed Parse = iota
+	ParseRose
+	ParseRuby
+	ParseGray
+	ParseGreen
+
+	ParseBlack Parse = iota + 5
+	ParseBlue
+	ParseBrown
+)
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ParseRed-0]
+	_ = x[ParseRose-1]
+	_ = x[ParseRuby-2]
+	_ = x[ParseGray-3]
+	_ = x[ParseGreen-4]
+	_ = x[ParseBlack-10]
+	_ = x[ParseBlue-11]
+	_ = x[ParseBrown-12]
+}
+
+const (
+	_Parse_name_0 = "RedRoseRubyGrayGreen"
+	_Parse_name_1 = "BlackBlueBrown"
+)
+
+var (
+	_Parse_index_0 = [...]uint8{0, 3, 7, 11, 15, 20}
+	_Parse_index_1 = [...]uint8{0, 5, 9, 14}
+)
+
+func (i Parse) String() string {
+	switch {
+	case 0 <= i && i <= 4:
+		return _Parse_name_0[_Parse_index_0[i]:_Parse_index_0[i+1]]
+	case 10 <= i && i <= 12:
+		i -= 10
+		return _Parse_name_1[_Parse_index_1[i]:_Parse_index_1[i+1]]
+	default:
+		return "Parse(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+func _Parse_lookup(s string) (Parse, bool) {
+	switch len(s) {
+	case 3:
+		if s == _Parse_name_0[0:3] {
+			return ParseRed, true
+		}
+	case 4:
+		switch s[1] {
+		case 'l':
+			if s == _Parse_name_1[5:9] {
+				return ParseBlue, true
+			}
+		case 'o':
+			if s == _Parse_name_0[3:7] {
+				return ParseRose, true
+			}
+		case 'r':
+			if s == _Parse_name_0[11:15] {
+				return ParseGray, true
+			}
+		case 'u':
+			if s == _Parse_name_0[7:11] {
+				return ParseRuby, true
+			}
+		}
+	case 5:
+		switch s[2] {
+		case 'a':
+			if s == _Parse_name_1[0:5] {
+				return ParseBlack, true
+			}
+		case 'e':
+			if s == _Parse_name_0[15:20] {
+				return ParseGreen, true
+			}
+		case 'o':
+			if s == _Parse_name_1[9:14] {
+				return ParseBrown, true
+			}
+		}
+	}
+	return 0, false
+}
+
+var _Parse_names = [...]string{
+	_Parse_name_0[0:3],
+	_Parse_name_0[3:7],
+	_Parse_name_0[7:11],
+	_Parse_name_0[11:15],
+	_Parse_name_0[15:20],
+	_Parse_name_1[0:5],
+	_Parse_name_1[5:9],
+	_Parse_name_1[9:14],
+}
+
+// ParseParse returns the Parse named s.
+func ParseParse(s string) (Parse, error) {
+	if i, ok := _Parse_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "Parse", Input: s, Valid: append([]string(nil), _Parse_names[:]...), Err: enum.ErrUnknownName}
+}
@@ -0,0 +1,12 @@
+package test
+
+type ParseFlags uint8
+
+const (
+	ParseFlagsNone ParseFlags = 0
+	ParseFlagsRead ParseFlags = 1 << iota
+	ParseFlagsWrite
+	ParseFlagsExec
+
+	ParseFlagsSticky ParseFlags = 1 << 6
+)
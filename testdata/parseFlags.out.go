@@ -0,0 +1,155 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ParseFlagsNone-0]
+	_ = x[ParseFlagsRead-2]
+	_ = x[ParseFlagsWrite-4]
+	_ = x[ParseFlagsExec-8]
+	_ = x[ParseFlagsSticky-64]
+}
+
+const (
+	_ParseFlags_name_0 = "NoneReadWriteExec"
+	_ParseFlags_name_1 = "Sticky"
+)
+
+var (
+	_ParseFlags_index_0 = [...]uint8{0, 4, 8, 13, 17}
+)
+
+func (i ParseFlags) AppendFlags(s []string) []string {
+	if i == 0 {
+		return append(s, _ParseFlags_name_0[_ParseFlags_index_0[0]:_ParseFlags_index_0[1]])
+	}
+
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _ParseFlags_name_0[_ParseFlags_index_0[1]:_ParseFlags_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _ParseFlags_name_0[_ParseFlags_index_0[2]:_ParseFlags_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _ParseFlags_name_0[_ParseFlags_index_0[3]:_ParseFlags_index_0[4]])
+	}
+	if i&64 != 0 {
+		i, s = i&^64, append(s, _ParseFlags_name_1)
+	}
+	if i != 0 {
+		s = append(s, "ParseFlags("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i ParseFlags) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i ParseFlags) AppendText(b []byte) ([]byte, error) {
+	if i == 0 {
+		return append(b, _ParseFlags_name_0[_ParseFlags_index_0[0]:_ParseFlags_index_0[1]]...), nil
+	}
+
+	n := len(b)
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _ParseFlags_name_0[_ParseFlags_index_0[1]:_ParseFlags_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _ParseFlags_name_0[_ParseFlags_index_0[2]:_ParseFlags_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _ParseFlags_name_0[_ParseFlags_index_0[3]:_ParseFlags_index_0[4]]...)
+	}
+	if i&64 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^64, append(b, _ParseFlags_name_1...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "ParseFlags("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i ParseFlags) String() string {
+	var buf [61]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func _ParseFlags_lookup(s string) (ParseFlags, bool) {
+	switch len(s) {
+	case 4:
+		switch s[0] {
+		case 'E':
+			if s == _ParseFlags_name_0[13:17] {
+				return ParseFlagsExec, true
+			}
+		case 'N':
+			if s == _ParseFlags_name_0[0:4] {
+				return ParseFlagsNone, true
+			}
+		case 'R':
+			if s == _ParseFlags_name_0[4:8] {
+				return ParseFlagsRead, true
+			}
+		}
+	case 5:
+		if s == _ParseFlags_name_0[8:13] {
+			return ParseFlagsWrite, true
+		}
+	case 6:
+		if s == _ParseFlags_name_1 {
+			return ParseFlagsSticky, true
+		}
+	}
+	return 0, false
+}
+
+var _ParseFlags_names = [...]string{
+	_ParseFlags_name_0[0:4],
+	_ParseFlags_name_0[4:8],
+	_ParseFlags_name_0[8:13],
+	_ParseFlags_name_0[13:17],
+	_ParseFlags_name_1,
+}
+
+// ParseParseFlags returns the ParseFlags made of the flags named in s, separated by
+// '+'. The empty string stands for no flags.
+func ParseParseFlags(s string) (ParseFlags, error) {
+	var i ParseFlags
+	for s != "" {
+		name, rest, _ := strings.Cut(s, "+")
+		f, ok := _ParseFlags_lookup(name)
+		if !ok {
+			return 0, &enum.ParseError{Type: "ParseFlags", Input: name, Valid: append([]string(nil), _ParseFlags_names[:]...), Err: enum.ErrUnknownName}
+		}
+		i, s = i|f, rest
+	}
+	return i, nil
+}
@@ -0,0 +1,11 @@
+package test
+
+type ParseFold int
+
+const (
+	ParseFoldDebug ParseFold = iota
+	ParseFoldInfo
+	ParseFoldWarn
+	ParseFoldWarning
+	ParseFoldError
+)
@@ -0,0 +1,108 @@
+package test
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ParseFoldDebug-0]
+	_ = x[ParseFoldInfo-1]
+	_ = x[ParseFoldWarn-2]
+	_ = x[ParseFoldWarning-3]
+	_ = x[ParseFoldError-4]
+}
+
+const _ParseFold_name = "DebugInfoWarnWarningError"
+
+var _ParseFold_index = [...]uint8{0, 5, 9, 13, 20, 25}
+
+func (i ParseFold) String() string {
+	if i < 0 || i >= ParseFold(len(_ParseFold_index)-1) {
+		return "ParseFold(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _ParseFold_name[_ParseFold_index[i]:_ParseFold_index[i+1]]
+}
+
+func _ParseFold_lookup(s string) (ParseFold, bool) {
+	switch len(s) {
+	case 4:
+		switch s[0] {
+		case 'I':
+			if s == _ParseFold_name[5:9] {
+				return ParseFoldInfo, true
+			}
+		case 'W':
+			if s == _ParseFold_name[9:13] {
+				return ParseFoldWarn, true
+			}
+		}
+	case 5:
+		switch s[0] {
+		case 'D':
+			if s == _ParseFold_name[0:5] {
+				return ParseFoldDebug, true
+			}
+		case 'E':
+			if s == _ParseFold_name[20:25] {
+				return ParseFoldError, true
+			}
+		}
+	case 7:
+		if s == _ParseFold_name[13:20] {
+			return ParseFoldWarning, true
+		}
+	}
+	return 0, false
+}
+
+var _ParseFold_names = [...]string{
+	_ParseFold_name[0:5],
+	_ParseFold_name[5:9],
+	_ParseFold_name[9:13],
+	_ParseFold_name[13:20],
+	_ParseFold_name[20:25],
+}
+
+var _ParseFold_values = [...]ParseFold{0, 1, 2, 3, 4}
+
+// ParseParseFold returns the ParseFold named s.
+func ParseParseFold(s string) (ParseFold, error) {
+	if i, ok := _ParseFold_lookup(s); ok {
+		return i, nil
+	}
+
+	for j, name := range _ParseFold_names {
+		if strings.EqualFold(s, name) {
+			return _ParseFold_values[j], nil
+		}
+	}
+
+	if s != "" {
+		var i ParseFold
+		var candidates []string
+		for j, name := range _ParseFold_names {
+			if len(s) < len(name) && strings.EqualFold(s, name[:len(s)]) {
+				i, candidates = _ParseFold_values[j], append(candidates, name)
+			}
+		}
+		if len(candidates) == 1 {
+			return i, nil
+		}
+		if len(candidates) > 1 {
+			return 0, &enum.ParseError{
+				Type:       "ParseFold",
+				Input:      s,
+				Valid:      append([]string(nil), _ParseFold_names[:]...),
+				Candidates: candidates,
+				Err:        enum.ErrAmbiguousName,
+			}
+		}
+	}
+	return 0, &enum.ParseError{Type: "ParseFold", Input: s, Valid: append([]string(nil), _ParseFold_names[:]...), Err: enum.ErrUnknownName}
+}
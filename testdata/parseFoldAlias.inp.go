@@ -0,0 +1,9 @@
+package test
+
+type ParseFoldAlias int
+
+const (
+	ParseFoldAliasRed   ParseFoldAlias = iota //stringer:alias "rojo"
+	ParseFoldAliasGreen                       //stringer:alias "verde", "GREEN"
+	ParseFoldAliasBlue
+)
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ParseFoldAliasRed-0]
+	_ = x[ParseFoldAliasGreen-1]
+	_ = x[ParseFoldAliasBlue-2]
+}
+
+const _ParseFoldAlias_name = "RedGreenBlue"
+
+var _ParseFoldAlias_index = [...]uint8{0, 3, 8, 12}
+
+func (i ParseFoldAlias) String() string {
+	if i < 0 || i >= ParseFoldAlias(len(_ParseFoldAlias_index)-1) {
+		return "ParseFoldAlias(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _ParseFoldAlias_name[_ParseFoldAlias_index[i]:_ParseFoldAlias_index[i+1]]
+}
+
+func _ParseFoldAlias_lookup(s string) (ParseFoldAlias, bool) {
+	switch len(s) {
+	case 3:
+		if s == _ParseFoldAlias_name[0:3] {
+			return ParseFoldAliasRed, true
+		}
+	case 4:
+		switch s[0] {
+		case 'B':
+			if s == _ParseFoldAlias_name[8:12] {
+				return ParseFoldAliasBlue, true
+			}
+		case 'r':
+			if s == "rojo" {
+				return ParseFoldAliasRed, true
+			}
+		}
+	case 5:
+		switch s[1] {
+		case 'R':
+			if s == "GREEN" {
+				return ParseFoldAliasGreen, true
+			}
+		case 'e':
+			if s == "verde" {
+				return ParseFoldAliasGreen, true
+			}
+		case 'r':
+			if s == _ParseFoldAlias_name[3:8] {
+				return ParseFoldAliasGreen, true
+			}
+		}
+	}
+	return 0, false
+}
+
+var _ParseFoldAlias_names = [...]string{
+	_ParseFoldAlias_name[0:3],
+	_ParseFoldAlias_name[3:8],
+	_ParseFoldAlias_name[8:12],
+}
+
+var _ParseFoldAlias_values = [...]ParseFoldAlias{0, 1, 2}
+
+var _ParseFoldAlias_aliases = [...]struct {
+	name  string
+	value ParseFoldAlias
+}{
+	{"rojo", ParseFoldAliasRed},
+	{"verde", ParseFoldAliasGreen},
+	{"GREEN", ParseFoldAliasGreen},
+}
+
+// ParseParseFoldAlias returns the ParseFoldAlias named s.
+func ParseParseFoldAlias(s string) (ParseFoldAlias, error) {
+	if i, ok := _ParseFoldAlias_lookup(s); ok {
+		return i, nil
+	}
+
+	for j, name := range _ParseFoldAlias_names {
+		if strings.EqualFold(s, name) {
+			return _ParseFoldAlias_values[j], nil
+		}
+	}
+
+	for _, alias := range _ParseFoldAlias_aliases {
+		if strings.EqualFold(s, alias.name) {
+			return alias.value, nil
+		}
+	}
+	return 0, &enum.ParseError{Type: "ParseFoldAlias", Input: s, Valid: append([]string(nil), _ParseFoldAlias_names[:]...), Err: enum.ErrUnknownName}
+}
@@ -0,0 +1,10 @@
+package test
+
+type ParsePrefix uint8
+
+const (
+	ParsePrefixRead ParsePrefix = 1 << iota
+	ParsePrefixWrite
+	ParsePrefixExec
+	ParsePrefixExclusive
+)
@@ -0,0 +1,171 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ParsePrefixRead-1]
+	_ = x[ParsePrefixWrite-2]
+	_ = x[ParsePrefixExec-4]
+	_ = x[ParsePrefixExclusive-8]
+}
+
+const (
+	_ParsePrefix_name_0 = "ReadWriteExecExclusive"
+)
+
+var (
+	_ParsePrefix_index_0 = [...]uint8{0, 4, 9, 13, 22}
+)
+
+func (i ParsePrefix) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _ParsePrefix_name_0[_ParsePrefix_index_0[0]:_ParsePrefix_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _ParsePrefix_name_0[_ParsePrefix_index_0[1]:_ParsePrefix_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _ParsePrefix_name_0[_ParsePrefix_index_0[2]:_ParsePrefix_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _ParsePrefix_name_0[_ParsePrefix_index_0[3]:_ParsePrefix_index_0[4]])
+	}
+	if i != 0 {
+		s = append(s, "ParsePrefix("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i ParsePrefix) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i ParsePrefix) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _ParsePrefix_name_0[_ParsePrefix_index_0[0]:_ParsePrefix_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _ParsePrefix_name_0[_ParsePrefix_index_0[1]:_ParsePrefix_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _ParsePrefix_name_0[_ParsePrefix_index_0[2]:_ParsePrefix_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _ParsePrefix_name_0[_ParsePrefix_index_0[3]:_ParsePrefix_index_0[4]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "ParsePrefix("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i ParsePrefix) String() string {
+	var buf [60]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func _ParsePrefix_lookup(s string) (ParsePrefix, bool) {
+	switch len(s) {
+	case 4:
+		switch s[0] {
+		case 'E':
+			if s == _ParsePrefix_name_0[9:13] {
+				return ParsePrefixExec, true
+			}
+		case 'R':
+			if s == _ParsePrefix_name_0[0:4] {
+				return ParsePrefixRead, true
+			}
+		}
+	case 5:
+		if s == _ParsePrefix_name_0[4:9] {
+			return ParsePrefixWrite, true
+		}
+	case 9:
+		if s == _ParsePrefix_name_0[13:22] {
+			return ParsePrefixExclusive, true
+		}
+	}
+	return 0, false
+}
+
+var _ParsePrefix_names = [...]string{
+	_ParsePrefix_name_0[0:4],
+	_ParsePrefix_name_0[4:9],
+	_ParsePrefix_name_0[9:13],
+	_ParsePrefix_name_0[13:22],
+}
+
+var _ParsePrefix_values = [...]ParsePrefix{1, 2, 4, 8}
+
+func _ParsePrefix_match(s string) (ParsePrefix, error) {
+	if i, ok := _ParsePrefix_lookup(s); ok {
+		return i, nil
+	}
+
+	if s != "" {
+		var i ParsePrefix
+		var candidates []string
+		for j, name := range _ParsePrefix_names {
+			if len(s) < len(name) && s == name[:len(s)] {
+				i, candidates = _ParsePrefix_values[j], append(candidates, name)
+			}
+		}
+		if len(candidates) == 1 {
+			return i, nil
+		}
+		if len(candidates) > 1 {
+			return 0, &enum.ParseError{
+				Type:       "ParsePrefix",
+				Input:      s,
+				Valid:      append([]string(nil), _ParsePrefix_names[:]...),
+				Candidates: candidates,
+				Err:        enum.ErrAmbiguousName,
+			}
+		}
+	}
+	return 0, &enum.ParseError{Type: "ParsePrefix", Input: s, Valid: append([]string(nil), _ParsePrefix_names[:]...), Err: enum.ErrUnknownName}
+}
+
+// ParseParsePrefix returns the ParsePrefix made of the flags named in s, separated by
+// '+'. The empty string stands for no flags.
+func ParseParsePrefix(s string) (ParsePrefix, error) {
+	var i ParsePrefix
+	for s != "" {
+		name, rest, _ := strings.Cut(s, "+")
+		f, err := _ParsePrefix_match(name)
+		if err != nil {
+			return 0, err
+		}
+		i, s = i|f, rest
+	}
+	return i, nil
+}
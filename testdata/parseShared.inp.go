@@ -0,0 +1,10 @@
+package test
+
+type ParseShared int
+
+const (
+	ParseSharedDebug ParseShared = iota
+	ParseSharedInfo
+	ParseSharedError
+	ParseSharedFatal ParseShared = ParseSharedError // Parsed as ParseSharedError.
+)
@@ -0,0 +1,67 @@
+package test
+
+import (
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ParseSharedDebug-0]
+	_ = x[ParseSharedInfo-1]
+	_ = x[ParseSharedError-2]
+	_ = x[ParseSharedFatal-2]
+}
+
+const _ParseShared_name = "DebugInfoError"
+
+var _ParseShared_index = [...]uint8{0, 5, 9, 14}
+
+func (i ParseShared) String() string {
+	if i < 0 || i >= ParseShared(len(_ParseShared_index)-1) {
+		return "ParseShared(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _ParseShared_name[_ParseShared_index[i]:_ParseShared_index[i+1]]
+}
+
+func _ParseShared_lookup(s string) (ParseShared, bool) {
+	switch len(s) {
+	case 4:
+		if s == _ParseShared_name[5:9] {
+			return ParseSharedInfo, true
+		}
+	case 5:
+		switch s[0] {
+		case 'D':
+			if s == _ParseShared_name[0:5] {
+				return ParseSharedDebug, true
+			}
+		case 'E':
+			if s == _ParseShared_name[9:14] {
+				return ParseSharedError, true
+			}
+		case 'F':
+			if s == "Fatal" {
+				return ParseSharedError, true
+			}
+		}
+	}
+	return 0, false
+}
+
+var _ParseShared_names = [...]string{
+	_ParseShared_name[0:5],
+	_ParseShared_name[5:9],
+	_ParseShared_name[9:14],
+}
+
+// ParseParseShared returns the ParseShared named s.
+func ParseParseShared(s string) (ParseShared, error) {
+	if i, ok := _ParseShared_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "ParseShared", Input: s, Valid: append([]string(nil), _ParseShared_names[:]...), Err: enum.ErrUnknownName}
+}
@@ -0,0 +1,12 @@
+package test
+
+type ParseSize uint16
+
+const (
+	ParseSizeOK       ParseSize = 200
+	ParseSizeCreated  ParseSize = 201
+	ParseSizeMoved    ParseSize = 301
+	ParseSizeNotFound ParseSize = 404
+	ParseSizeGone     ParseSize = 410
+	ParseSizeInternal ParseSize = 500
+)
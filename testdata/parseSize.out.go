@@ -0,0 +1,67 @@
+package test
+
+import (
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ParseSizeOK-200]
+	_ = x[ParseSizeCreated-201]
+	_ = x[ParseSizeMoved-301]
+	_ = x[ParseSizeNotFound-404]
+	_ = x[ParseSizeGone-410]
+	_ = x[ParseSizeInternal-500]
+}
+
+const _ParseSize_name = "OKCreatedMovedNotFoundGoneInternal"
+
+var _ParseSize_values = [...]ParseSize{200, 201, 301, 404, 410, 500}
+
+var _ParseSize_index = [...]uint8{0, 2, 9, 14, 22, 26, 34}
+
+func (i ParseSize) String() string {
+	lo, hi := 0, len(_ParseSize_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _ParseSize_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_ParseSize_values) && _ParseSize_values[lo] == i {
+		return _ParseSize_name[_ParseSize_index[lo]:_ParseSize_index[lo+1]]
+	}
+	return "ParseSize(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+func _ParseSize_lookup(s string) (ParseSize, bool) {
+	for j, v := range _ParseSize_values {
+		if s == _ParseSize_name[_ParseSize_index[j]:_ParseSize_index[j+1]] {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+var _ParseSize_names = [...]string{
+	_ParseSize_name[0:2],
+	_ParseSize_name[2:9],
+	_ParseSize_name[9:14],
+	_ParseSize_name[14:22],
+	_ParseSize_name[22:26],
+	_ParseSize_name[26:34],
+}
+
+// ParseParseSize returns the ParseSize named s.
+func ParseParseSize(s string) (ParseSize, error) {
+	if i, ok := _ParseSize_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "ParseSize", Input: s, Valid: append([]string(nil), _ParseSize_names[:]...), Err: enum.ErrUnknownName}
+}
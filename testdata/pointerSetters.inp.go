@@ -0,0 +1,8 @@
+package test
+
+type PointerSetters uint
+
+const (
+	PointerSettersRead PointerSetters = 1 << iota
+	PointerSettersWrite
+)
@@ -0,0 +1,90 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[PointerSettersRead-1]
+	_ = x[PointerSettersWrite-2]
+}
+
+const (
+	_PointerSetters_name_0 = "ReadWrite"
+)
+
+var (
+	_PointerSetters_index_0 = [...]uint8{0, 4, 9}
+)
+
+func (i PointerSetters) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _PointerSetters_name_0[_PointerSetters_index_0[0]:_PointerSetters_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _PointerSetters_name_0[_PointerSetters_index_0[1]:_PointerSetters_index_0[2]])
+	}
+	if i != 0 {
+		s = append(s, "PointerSetters("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i PointerSetters) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount(uint(i))))
+}
+
+func (i PointerSetters) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _PointerSetters_name_0[_PointerSetters_index_0[0]:_PointerSetters_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _PointerSetters_name_0[_PointerSetters_index_0[1]:_PointerSetters_index_0[2]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "PointerSetters("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i PointerSetters) String() string {
+	var buf [48]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func (i PointerSetters) Read() bool { return i&PointerSettersRead == PointerSettersRead }
+func (i *PointerSetters) SetRead(v bool) {
+	if v {
+		*i |= PointerSettersRead
+	} else {
+		*i &^= PointerSettersRead
+	}
+}
+func (i *PointerSetters) ToggleRead() { *i ^= PointerSettersRead }
+
+func (i PointerSetters) Write() bool { return i&PointerSettersWrite == PointerSettersWrite }
+func (i *PointerSetters) SetWrite(v bool) {
+	if v {
+		*i |= PointerSettersWrite
+	} else {
+		*i &^= PointerSettersWrite
+	}
+}
+func (i *PointerSetters) ToggleWrite() { *i ^= PointerSettersWrite }
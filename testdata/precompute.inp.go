@@ -0,0 +1,13 @@
+package test
+
+type Precompute uint8
+
+const (
+	PrecomputeNone Precompute = 0
+	PrecomputeSYN  Precompute = 1 << iota
+	PrecomputeACK
+	PrecomputeFIN
+
+	PrecomputeRST Precompute = 1 << 6
+	PrecomputeURG Precompute = 1 << 7
+)
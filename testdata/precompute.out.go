@@ -0,0 +1,121 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[PrecomputeNone-0]
+	_ = x[PrecomputeSYN-2]
+	_ = x[PrecomputeACK-4]
+	_ = x[PrecomputeFIN-8]
+	_ = x[PrecomputeRST-64]
+	_ = x[PrecomputeURG-128]
+}
+
+const (
+	_Precompute_name_0 = "NoneSYNACKFIN"
+	_Precompute_name_1 = "RSTURG"
+)
+
+var (
+	_Precompute_index_0 = [...]uint8{0, 4, 7, 10, 13}
+	_Precompute_index_1 = [...]uint8{0, 3, 6}
+)
+
+func (i Precompute) AppendFlags(s []string) []string {
+	if i == 0 {
+		return append(s, _Precompute_name_0[_Precompute_index_0[0]:_Precompute_index_0[1]])
+	}
+
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Precompute_name_0[_Precompute_index_0[1]:_Precompute_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Precompute_name_0[_Precompute_index_0[2]:_Precompute_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _Precompute_name_0[_Precompute_index_0[3]:_Precompute_index_0[4]])
+	}
+	if i&64 != 0 {
+		i, s = i&^64, append(s, _Precompute_name_1[_Precompute_index_1[0]:_Precompute_index_1[1]])
+	}
+	if i&128 != 0 {
+		i, s = i&^128, append(s, _Precompute_name_1[_Precompute_index_1[1]:_Precompute_index_1[2]])
+	}
+	if i != 0 {
+		s = append(s, "Precompute("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Precompute) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i Precompute) AppendText(b []byte) ([]byte, error) {
+	if i == 0 {
+		return append(b, _Precompute_name_0[_Precompute_index_0[0]:_Precompute_index_0[1]]...), nil
+	}
+
+	n := len(b)
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Precompute_name_0[_Precompute_index_0[1]:_Precompute_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Precompute_name_0[_Precompute_index_0[2]:_Precompute_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _Precompute_name_0[_Precompute_index_0[3]:_Precompute_index_0[4]]...)
+	}
+	if i&64 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^64, append(b, _Precompute_name_1[_Precompute_index_1[0]:_Precompute_index_1[1]]...)
+	}
+	if i&128 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^128, append(b, _Precompute_name_1[_Precompute_index_1[1]:_Precompute_index_1[2]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Precompute("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+const _Precompute_mask Precompute = 0xce
+const _Precompute_combo_name = "NoneSYNACKSYN+ACKFINSYN+FINACK+FINSYN+ACK+FINRSTSYN+RSTACK+RSTSYN+ACK+RSTFIN+RSTSYN+FIN+RSTACK+FIN+RSTSYN+ACK+FIN+RSTURGSYN+URGACK+URGSYN+ACK+URGFIN+URGSYN+FIN+URGACK+FIN+URGSYN+ACK+FIN+URGRST+URGSYN+RST+URGACK+RST+URGSYN+ACK+RST+URGFIN+RST+URGSYN+FIN+RST+URGACK+FIN+RST+URGSYN+ACK+FIN+RST+URG"
+
+var _Precompute_combo_index = [...]uint16{0, 4, 7, 10, 17, 20, 27, 34, 45, 48, 55, 62, 73, 80, 91, 102, 117, 120, 127, 134, 145, 152, 163, 174, 189, 196, 207, 218, 233, 244, 259, 274, 293}
+
+func (i Precompute) String() string {
+	if i&^_Precompute_mask == 0 {
+		j := uint64(i)>>1&0x7 | uint64(i)>>6&0x3<<3
+		return _Precompute_combo_name[_Precompute_combo_index[j]:_Precompute_combo_index[j+1]]
+	}
+
+	var buf [58]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
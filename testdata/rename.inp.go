@@ -0,0 +1,18 @@
+package test
+
+type Rename uint
+
+const (
+	RenameRead Rename = 1 << iota
+	RenameWrite
+)
+
+// String wraps the generated name in brackets.
+func (i Rename) String() string {
+	return "[" + i.renameName() + "]"
+}
+
+// Read reports whether the value can be read from.
+func (i Rename) Read() bool {
+	return i.renameRead()
+}
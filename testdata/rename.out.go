@@ -0,0 +1,78 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[RenameRead-1]
+	_ = x[RenameWrite-2]
+}
+
+const (
+	_Rename_name_0 = "ReadWrite"
+)
+
+var (
+	_Rename_index_0 = [...]uint8{0, 4, 9}
+)
+
+func (i Rename) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _Rename_name_0[_Rename_index_0[0]:_Rename_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Rename_name_0[_Rename_index_0[1]:_Rename_index_0[2]])
+	}
+	if i != 0 {
+		s = append(s, "Rename("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Rename) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount(uint(i))))
+}
+
+func (i Rename) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _Rename_name_0[_Rename_index_0[0]:_Rename_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Rename_name_0[_Rename_index_0[1]:_Rename_index_0[2]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Rename("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Rename) renameName() string {
+	var buf [40]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func (i Rename) renameRead() bool  { return i&RenameRead == RenameRead }
+func (i Rename) SetRead() Rename   { return i | RenameRead }
+func (i Rename) ClearRead() Rename { return i & ^RenameRead }
+
+func (i Rename) Write() bool        { return i&RenameWrite == RenameWrite }
+func (i Rename) SetWrite() Rename   { return i | RenameWrite }
+func (i Rename) ClearWrite() Rename { return i & ^RenameWrite }
@@ -0,0 +1,27 @@
+package test
+
+type Search int
+
+const (
+	SearchContinue  Search = 100
+	SearchSwitching Search = 101
+
+	SearchOK        Search = 200
+	SearchCreated   Search = 201
+	SearchAccepted  Search = 202
+	SearchNoContent Search = 204
+
+	SearchMoved       Search = 301
+	SearchFound       Search = 302
+	SearchNotModified Search = 304
+
+	SearchBadRequest   Search = 400
+	SearchUnauthorized Search = 401
+	SearchForbidden    Search = 403
+	SearchNotFound     Search = 404
+	SearchConflict     Search = 409
+
+	SearchInternal    Search = 500
+	SearchBadGateway  Search = 502
+	SearchUnavailable Search = 503
+)
@@ -0,0 +1,50 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SearchContinue-100]
+	_ = x[SearchSwitching-101]
+	_ = x[SearchOK-200]
+	_ = x[SearchCreated-201]
+	_ = x[SearchAccepted-202]
+	_ = x[SearchNoContent-204]
+	_ = x[SearchMoved-301]
+	_ = x[SearchFound-302]
+	_ = x[SearchNotModified-304]
+	_ = x[SearchBadRequest-400]
+	_ = x[SearchUnauthorized-401]
+	_ = x[SearchForbidden-403]
+	_ = x[SearchNotFound-404]
+	_ = x[SearchConflict-409]
+	_ = x[SearchInternal-500]
+	_ = x[SearchBadGateway-502]
+	_ = x[SearchUnavailable-503]
+}
+
+const _Search_name = "ContinueSwitchingOKCreatedAcceptedNoContentMovedFoundNotModifiedBadRequestUnauthorizedForbiddenNotFoundConflictInternalBadGatewayUnavailable"
+
+var _Search_values = [...]Search{100, 101, 200, 201, 202, 204, 301, 302, 304, 400, 401, 403, 404, 409, 500, 502, 503}
+
+var _Search_index = [...]uint8{0, 8, 17, 19, 26, 34, 43, 48, 53, 64, 74, 86, 95, 103, 111, 119, 129, 140}
+
+func (i Search) String() string {
+	lo, hi := 0, len(_Search_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _Search_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_Search_values) && _Search_values[lo] == i {
+		return _Search_name[_Search_index[lo]:_Search_index[lo+1]]
+	}
+	return "Search(" + strconv.FormatInt(int64(i), 10) + ")"
+}
@@ -0,0 +1,13 @@
+package test
+
+type Set int
+
+const (
+	SetSunday Set = iota
+	SetMonday
+	SetTuesday
+	SetWednesday
+	SetThursday
+	SetFriday
+	SetSaturday
+)
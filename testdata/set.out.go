@@ -0,0 +1,222 @@
+package test
+
+import (
+	"encoding/json"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SetSunday-0]
+	_ = x[SetMonday-1]
+	_ = x[SetTuesday-2]
+	_ = x[SetWednesday-3]
+	_ = x[SetThursday-4]
+	_ = x[SetFriday-5]
+	_ = x[SetSaturday-6]
+}
+
+const _Set_name = "SundayMondayTuesdayWednesdayThursdayFridaySaturday"
+
+var _Set_index = [...]uint8{0, 6, 12, 19, 28, 36, 42, 50}
+
+func (i Set) String() string {
+	if i < 0 || i >= Set(len(_Set_index)-1) {
+		return "Set(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Set_name[_Set_index[i]:_Set_index[i+1]]
+}
+
+func _Set_lookup(s string) (Set, bool) {
+	switch len(s) {
+	case 6:
+		switch s[0] {
+		case 'F':
+			if s == _Set_name[36:42] {
+				return SetFriday, true
+			}
+		case 'M':
+			if s == _Set_name[6:12] {
+				return SetMonday, true
+			}
+		case 'S':
+			if s == _Set_name[0:6] {
+				return SetSunday, true
+			}
+		}
+	case 7:
+		if s == _Set_name[12:19] {
+			return SetTuesday, true
+		}
+	case 8:
+		switch s[0] {
+		case 'S':
+			if s == _Set_name[42:50] {
+				return SetSaturday, true
+			}
+		case 'T':
+			if s == _Set_name[28:36] {
+				return SetThursday, true
+			}
+		}
+	case 9:
+		if s == _Set_name[19:28] {
+			return SetWednesday, true
+		}
+	}
+	return 0, false
+}
+
+var _Set_names = [...]string{
+	_Set_name[0:6],
+	_Set_name[6:12],
+	_Set_name[12:19],
+	_Set_name[19:28],
+	_Set_name[28:36],
+	_Set_name[36:42],
+	_Set_name[42:50],
+}
+
+// ParseSet returns the Set named s.
+func ParseSet(s string) (Set, error) {
+	if i, ok := _Set_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "Set", Input: s, Valid: append([]string(nil), _Set_names[:]...), Err: enum.ErrUnknownName}
+}
+
+// _Set_ordinal returns the position of i among the values of Set, or -1.
+func _Set_ordinal(i Set) int {
+	switch {
+	case i >= 0 && i <= 6:
+		return int(i)
+	}
+	return -1
+}
+
+var _Set_values = [...]Set{0, 1, 2, 3, 4, 5, 6}
+
+// SetSet is a set of Set values, holding one bit per value.
+type SetSet struct {
+	bits [1]uint64
+}
+
+// NewSetSet returns the set of the given values.
+func NewSetSet(values ...Set) SetSet {
+	var s SetSet
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add adds v to s. Values not defined by Set are ignored.
+func (s *SetSet) Add(v Set) {
+	if j := _Set_ordinal(v); j >= 0 {
+		s.bits[j/64] |= 1 << (j % 64)
+	}
+}
+
+// Remove removes v from s.
+func (s *SetSet) Remove(v Set) {
+	if j := _Set_ordinal(v); j >= 0 {
+		s.bits[j/64] &^= 1 << (j % 64)
+	}
+}
+
+// Contains reports whether v is in s.
+func (s SetSet) Contains(v Set) bool {
+	j := _Set_ordinal(v)
+	return j >= 0 && s.bits[j/64]&(1<<(j%64)) != 0
+}
+
+// Len returns the number of values in s.
+func (s SetSet) Len() int {
+	n := 0
+	for _, w := range s.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All calls yield with the values in s, in increasing order, until it
+// returns false. With Go 1.23 or later, range over s.All to do so.
+func (s SetSet) All(yield func(Set) bool) {
+	for k, w := range s.bits {
+		for ; w != 0; w &= w - 1 {
+			if !yield(_Set_values[k*64+bits.TrailingZeros64(w)]) {
+				return
+			}
+		}
+	}
+}
+
+// names returns the names of the values in s.
+func (s SetSet) names() []string {
+	var names []string
+	s.All(func(v Set) bool {
+		names = append(names, v.String())
+		return true
+	})
+	return names
+}
+
+// String returns the names of the values in s, such as [A B].
+func (s SetSet) String() string {
+	return "[" + strings.Join(s.names(), " ") + "]"
+}
+
+// MarshalText implements encoding.TextMarshaler, separating names by commas.
+func (s SetSet) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(s.names(), ",")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SetSet) UnmarshalText(b []byte) error {
+	var t SetSet
+	for _, name := range strings.Split(string(b), ",") {
+		if name == "" {
+			continue
+		}
+		v, err := ParseSet(name)
+		if err != nil {
+			return err
+		}
+		t.Add(v)
+	}
+	*s = t
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding s as an array of names.
+func (s SetSet) MarshalJSON() ([]byte, error) {
+	names := s.names()
+	if names == nil {
+		names = []string{}
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SetSet) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	var t SetSet
+	for _, name := range names {
+		v, err := ParseSet(name)
+		if err != nil {
+			return err
+		}
+		t.Add(v)
+	}
+	*s = t
+	return nil
+}
@@ -0,0 +1,76 @@
+package test
+
+type SetLarge int
+
+const (
+	SetLarge00 SetLarge = iota
+	SetLarge01
+	SetLarge02
+	SetLarge03
+	SetLarge04
+	SetLarge05
+	SetLarge06
+	SetLarge07
+	SetLarge08
+	SetLarge09
+	SetLarge10
+	SetLarge11
+	SetLarge12
+	SetLarge13
+	SetLarge14
+	SetLarge15
+	SetLarge16
+	SetLarge17
+	SetLarge18
+	SetLarge19
+	SetLarge20
+	SetLarge21
+	SetLarge22
+	SetLarge23
+	SetLarge24
+	SetLarge25
+	SetLarge26
+	SetLarge27
+	SetLarge28
+	SetLarge29
+	SetLarge30
+	SetLarge31
+	SetLarge32
+	SetLarge33
+	SetLarge34
+	SetLarge35
+	SetLarge36
+	SetLarge37
+	SetLarge38
+	SetLarge39
+	SetLarge40
+	SetLarge41
+	SetLarge42
+	SetLarge43
+	SetLarge44
+	SetLarge45
+	SetLarge46
+	SetLarge47
+	SetLarge48
+	SetLarge49
+	SetLarge50
+	SetLarge51
+	SetLarge52
+	SetLarge53
+	SetLarge54
+	SetLarge55
+	SetLarge56
+	SetLarge57
+	SetLarge58
+	SetLarge59
+	SetLarge60
+	SetLarge61
+	SetLarge62
+	SetLarge63
+	SetLarge64
+	SetLarge65
+	SetLarge66
+	SetLarge67
+	SetLarge68
+	SetLarge69
+)
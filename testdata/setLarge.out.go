@@ -0,0 +1,627 @@
+package test
+
+import (
+	"encoding/json"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SetLarge00-0]
+	_ = x[SetLarge01-1]
+	_ = x[SetLarge02-2]
+	_ = x[SetLarge03-3]
+	_ = x[SetLarge04-4]
+	_ = x[SetLarge05-5]
+	_ = x[SetLarge06-6]
+	_ = x[SetLarge07-7]
+	_ = x[SetLarge08-8]
+	_ = x[SetLarge09-9]
+	_ = x[SetLarge10-10]
+	_ = x[SetLarge11-11]
+	_ = x[SetLarge12-12]
+	_ = x[SetLarge13-13]
+	_ = x[SetLarge14-14]
+	_ = x[SetLarge15-15]
+	_ = x[SetLarge16-16]
+	_ = x[SetLarge17-17]
+	_ = x[SetLarge18-18]
+	_ = x[SetLarge19-19]
+	_ = x[SetLarge20-20]
+	_ = x[SetLarge21-21]
+	_ = x[SetLarge22-22]
+	_ = x[SetLarge23-23]
+	_ = x[SetLarge24-24]
+	_ = x[SetLarge25-25]
+	_ = x[SetLarge26-26]
+	_ = x[SetLarge27-27]
+	_ = x[SetLarge28-28]
+	_ = x[SetLarge29-29]
+	_ = x[SetLarge30-30]
+	_ = x[SetLarge31-31]
+	_ = x[SetLarge32-32]
+	_ = x[SetLarge33-33]
+	_ = x[SetLarge34-34]
+	_ = x[SetLarge35-35]
+	_ = x[SetLarge36-36]
+	_ = x[SetLarge37-37]
+	_ = x[SetLarge38-38]
+	_ = x[SetLarge39-39]
+	_ = x[SetLarge40-40]
+	_ = x[SetLarge41-41]
+	_ = x[SetLarge42-42]
+	_ = x[SetLarge43-43]
+	_ = x[SetLarge44-44]
+	_ = x[SetLarge45-45]
+	_ = x[SetLarge46-46]
+	_ = x[SetLarge47-47]
+	_ = x[SetLarge48-48]
+	_ = x[SetLarge49-49]
+	_ = x[SetLarge50-50]
+	_ = x[SetLarge51-51]
+	_ = x[SetLarge52-52]
+	_ = x[SetLarge53-53]
+	_ = x[SetLarge54-54]
+	_ = x[SetLarge55-55]
+	_ = x[SetLarge56-56]
+	_ = x[SetLarge57-57]
+	_ = x[SetLarge58-58]
+	_ = x[SetLarge59-59]
+	_ = x[SetLarge60-60]
+	_ = x[SetLarge61-61]
+	_ = x[SetLarge62-62]
+	_ = x[SetLarge63-63]
+	_ = x[SetLarge64-64]
+	_ = x[SetLarge65-65]
+	_ = x[SetLarge66-66]
+	_ = x[SetLarge67-67]
+	_ = x[SetLarge68-68]
+	_ = x[SetLarge69-69]
+}
+
+const _SetLarge_name = "00010203040506070809101112131415161718192021222324252627282930313233343536373839404142434445464748495051525354555657585960616263646566676869"
+
+var _SetLarge_index = [...]uint8{0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140}
+
+func (i SetLarge) String() string {
+	if i < 0 || i >= SetLarge(len(_SetLarge_index)-1) {
+		return "SetLarge(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _SetLarge_name[_SetLarge_index[i]:_SetLarge_index[i+1]]
+}
+
+func _SetLarge_lookup(s string) (SetLarge, bool) {
+	switch len(s) {
+	case 2:
+		switch s[1] {
+		case '0':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[0:2] {
+					return SetLarge00, true
+				}
+			case '1':
+				if s == _SetLarge_name[20:22] {
+					return SetLarge10, true
+				}
+			case '2':
+				if s == _SetLarge_name[40:42] {
+					return SetLarge20, true
+				}
+			case '3':
+				if s == _SetLarge_name[60:62] {
+					return SetLarge30, true
+				}
+			case '4':
+				if s == _SetLarge_name[80:82] {
+					return SetLarge40, true
+				}
+			case '5':
+				if s == _SetLarge_name[100:102] {
+					return SetLarge50, true
+				}
+			case '6':
+				if s == _SetLarge_name[120:122] {
+					return SetLarge60, true
+				}
+			}
+		case '1':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[2:4] {
+					return SetLarge01, true
+				}
+			case '1':
+				if s == _SetLarge_name[22:24] {
+					return SetLarge11, true
+				}
+			case '2':
+				if s == _SetLarge_name[42:44] {
+					return SetLarge21, true
+				}
+			case '3':
+				if s == _SetLarge_name[62:64] {
+					return SetLarge31, true
+				}
+			case '4':
+				if s == _SetLarge_name[82:84] {
+					return SetLarge41, true
+				}
+			case '5':
+				if s == _SetLarge_name[102:104] {
+					return SetLarge51, true
+				}
+			case '6':
+				if s == _SetLarge_name[122:124] {
+					return SetLarge61, true
+				}
+			}
+		case '2':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[4:6] {
+					return SetLarge02, true
+				}
+			case '1':
+				if s == _SetLarge_name[24:26] {
+					return SetLarge12, true
+				}
+			case '2':
+				if s == _SetLarge_name[44:46] {
+					return SetLarge22, true
+				}
+			case '3':
+				if s == _SetLarge_name[64:66] {
+					return SetLarge32, true
+				}
+			case '4':
+				if s == _SetLarge_name[84:86] {
+					return SetLarge42, true
+				}
+			case '5':
+				if s == _SetLarge_name[104:106] {
+					return SetLarge52, true
+				}
+			case '6':
+				if s == _SetLarge_name[124:126] {
+					return SetLarge62, true
+				}
+			}
+		case '3':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[6:8] {
+					return SetLarge03, true
+				}
+			case '1':
+				if s == _SetLarge_name[26:28] {
+					return SetLarge13, true
+				}
+			case '2':
+				if s == _SetLarge_name[46:48] {
+					return SetLarge23, true
+				}
+			case '3':
+				if s == _SetLarge_name[66:68] {
+					return SetLarge33, true
+				}
+			case '4':
+				if s == _SetLarge_name[86:88] {
+					return SetLarge43, true
+				}
+			case '5':
+				if s == _SetLarge_name[106:108] {
+					return SetLarge53, true
+				}
+			case '6':
+				if s == _SetLarge_name[126:128] {
+					return SetLarge63, true
+				}
+			}
+		case '4':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[8:10] {
+					return SetLarge04, true
+				}
+			case '1':
+				if s == _SetLarge_name[28:30] {
+					return SetLarge14, true
+				}
+			case '2':
+				if s == _SetLarge_name[48:50] {
+					return SetLarge24, true
+				}
+			case '3':
+				if s == _SetLarge_name[68:70] {
+					return SetLarge34, true
+				}
+			case '4':
+				if s == _SetLarge_name[88:90] {
+					return SetLarge44, true
+				}
+			case '5':
+				if s == _SetLarge_name[108:110] {
+					return SetLarge54, true
+				}
+			case '6':
+				if s == _SetLarge_name[128:130] {
+					return SetLarge64, true
+				}
+			}
+		case '5':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[10:12] {
+					return SetLarge05, true
+				}
+			case '1':
+				if s == _SetLarge_name[30:32] {
+					return SetLarge15, true
+				}
+			case '2':
+				if s == _SetLarge_name[50:52] {
+					return SetLarge25, true
+				}
+			case '3':
+				if s == _SetLarge_name[70:72] {
+					return SetLarge35, true
+				}
+			case '4':
+				if s == _SetLarge_name[90:92] {
+					return SetLarge45, true
+				}
+			case '5':
+				if s == _SetLarge_name[110:112] {
+					return SetLarge55, true
+				}
+			case '6':
+				if s == _SetLarge_name[130:132] {
+					return SetLarge65, true
+				}
+			}
+		case '6':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[12:14] {
+					return SetLarge06, true
+				}
+			case '1':
+				if s == _SetLarge_name[32:34] {
+					return SetLarge16, true
+				}
+			case '2':
+				if s == _SetLarge_name[52:54] {
+					return SetLarge26, true
+				}
+			case '3':
+				if s == _SetLarge_name[72:74] {
+					return SetLarge36, true
+				}
+			case '4':
+				if s == _SetLarge_name[92:94] {
+					return SetLarge46, true
+				}
+			case '5':
+				if s == _SetLarge_name[112:114] {
+					return SetLarge56, true
+				}
+			case '6':
+				if s == _SetLarge_name[132:134] {
+					return SetLarge66, true
+				}
+			}
+		case '7':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[14:16] {
+					return SetLarge07, true
+				}
+			case '1':
+				if s == _SetLarge_name[34:36] {
+					return SetLarge17, true
+				}
+			case '2':
+				if s == _SetLarge_name[54:56] {
+					return SetLarge27, true
+				}
+			case '3':
+				if s == _SetLarge_name[74:76] {
+					return SetLarge37, true
+				}
+			case '4':
+				if s == _SetLarge_name[94:96] {
+					return SetLarge47, true
+				}
+			case '5':
+				if s == _SetLarge_name[114:116] {
+					return SetLarge57, true
+				}
+			case '6':
+				if s == _SetLarge_name[134:136] {
+					return SetLarge67, true
+				}
+			}
+		case '8':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[16:18] {
+					return SetLarge08, true
+				}
+			case '1':
+				if s == _SetLarge_name[36:38] {
+					return SetLarge18, true
+				}
+			case '2':
+				if s == _SetLarge_name[56:58] {
+					return SetLarge28, true
+				}
+			case '3':
+				if s == _SetLarge_name[76:78] {
+					return SetLarge38, true
+				}
+			case '4':
+				if s == _SetLarge_name[96:98] {
+					return SetLarge48, true
+				}
+			case '5':
+				if s == _SetLarge_name[116:118] {
+					return SetLarge58, true
+				}
+			case '6':
+				if s == _SetLarge_name[136:138] {
+					return SetLarge68, true
+				}
+			}
+		case '9':
+			switch s[0] {
+			case '0':
+				if s == _SetLarge_name[18:20] {
+					return SetLarge09, true
+				}
+			case '1':
+				if s == _SetLarge_name[38:40] {
+					return SetLarge19, true
+				}
+			case '2':
+				if s == _SetLarge_name[58:60] {
+					return SetLarge29, true
+				}
+			case '3':
+				if s == _SetLarge_name[78:80] {
+					return SetLarge39, true
+				}
+			case '4':
+				if s == _SetLarge_name[98:100] {
+					return SetLarge49, true
+				}
+			case '5':
+				if s == _SetLarge_name[118:120] {
+					return SetLarge59, true
+				}
+			case '6':
+				if s == _SetLarge_name[138:140] {
+					return SetLarge69, true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+var _SetLarge_names = [...]string{
+	_SetLarge_name[0:2],
+	_SetLarge_name[2:4],
+	_SetLarge_name[4:6],
+	_SetLarge_name[6:8],
+	_SetLarge_name[8:10],
+	_SetLarge_name[10:12],
+	_SetLarge_name[12:14],
+	_SetLarge_name[14:16],
+	_SetLarge_name[16:18],
+	_SetLarge_name[18:20],
+	_SetLarge_name[20:22],
+	_SetLarge_name[22:24],
+	_SetLarge_name[24:26],
+	_SetLarge_name[26:28],
+	_SetLarge_name[28:30],
+	_SetLarge_name[30:32],
+	_SetLarge_name[32:34],
+	_SetLarge_name[34:36],
+	_SetLarge_name[36:38],
+	_SetLarge_name[38:40],
+	_SetLarge_name[40:42],
+	_SetLarge_name[42:44],
+	_SetLarge_name[44:46],
+	_SetLarge_name[46:48],
+	_SetLarge_name[48:50],
+	_SetLarge_name[50:52],
+	_SetLarge_name[52:54],
+	_SetLarge_name[54:56],
+	_SetLarge_name[56:58],
+	_SetLarge_name[58:60],
+	_SetLarge_name[60:62],
+	_SetLarge_name[62:64],
+	_SetLarge_name[64:66],
+	_SetLarge_name[66:68],
+	_SetLarge_name[68:70],
+	_SetLarge_name[70:72],
+	_SetLarge_name[72:74],
+	_SetLarge_name[74:76],
+	_SetLarge_name[76:78],
+	_SetLarge_name[78:80],
+	_SetLarge_name[80:82],
+	_SetLarge_name[82:84],
+	_SetLarge_name[84:86],
+	_SetLarge_name[86:88],
+	_SetLarge_name[88:90],
+	_SetLarge_name[90:92],
+	_SetLarge_name[92:94],
+	_SetLarge_name[94:96],
+	_SetLarge_name[96:98],
+	_SetLarge_name[98:100],
+	_SetLarge_name[100:102],
+	_SetLarge_name[102:104],
+	_SetLarge_name[104:106],
+	_SetLarge_name[106:108],
+	_SetLarge_name[108:110],
+	_SetLarge_name[110:112],
+	_SetLarge_name[112:114],
+	_SetLarge_name[114:116],
+	_SetLarge_name[116:118],
+	_SetLarge_name[118:120],
+	_SetLarge_name[120:122],
+	_SetLarge_name[122:124],
+	_SetLarge_name[124:126],
+	_SetLarge_name[126:128],
+	_SetLarge_name[128:130],
+	_SetLarge_name[130:132],
+	_SetLarge_name[132:134],
+	_SetLarge_name[134:136],
+	_SetLarge_name[136:138],
+	_SetLarge_name[138:140],
+}
+
+// ParseSetLarge returns the SetLarge named s.
+func ParseSetLarge(s string) (SetLarge, error) {
+	if i, ok := _SetLarge_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "SetLarge", Input: s, Valid: append([]string(nil), _SetLarge_names[:]...), Err: enum.ErrUnknownName}
+}
+
+// _SetLarge_ordinal returns the position of i among the values of SetLarge, or -1.
+func _SetLarge_ordinal(i SetLarge) int {
+	switch {
+	case i >= 0 && i <= 69:
+		return int(i)
+	}
+	return -1
+}
+
+var _SetLarge_values = [...]SetLarge{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69}
+
+// SetLargeSet is a set of SetLarge values, holding one bit per value.
+type SetLargeSet struct {
+	bits [2]uint64
+}
+
+// NewSetLargeSet returns the set of the given values.
+func NewSetLargeSet(values ...SetLarge) SetLargeSet {
+	var s SetLargeSet
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add adds v to s. Values not defined by SetLarge are ignored.
+func (s *SetLargeSet) Add(v SetLarge) {
+	if j := _SetLarge_ordinal(v); j >= 0 {
+		s.bits[j/64] |= 1 << (j % 64)
+	}
+}
+
+// Remove removes v from s.
+func (s *SetLargeSet) Remove(v SetLarge) {
+	if j := _SetLarge_ordinal(v); j >= 0 {
+		s.bits[j/64] &^= 1 << (j % 64)
+	}
+}
+
+// Contains reports whether v is in s.
+func (s SetLargeSet) Contains(v SetLarge) bool {
+	j := _SetLarge_ordinal(v)
+	return j >= 0 && s.bits[j/64]&(1<<(j%64)) != 0
+}
+
+// Len returns the number of values in s.
+func (s SetLargeSet) Len() int {
+	n := 0
+	for _, w := range s.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All calls yield with the values in s, in increasing order, until it
+// returns false. With Go 1.23 or later, range over s.All to do so.
+func (s SetLargeSet) All(yield func(SetLarge) bool) {
+	for k, w := range s.bits {
+		for ; w != 0; w &= w - 1 {
+			if !yield(_SetLarge_values[k*64+bits.TrailingZeros64(w)]) {
+				return
+			}
+		}
+	}
+}
+
+// names returns the names of the values in s.
+func (s SetLargeSet) names() []string {
+	var names []string
+	s.All(func(v SetLarge) bool {
+		names = append(names, v.String())
+		return true
+	})
+	return names
+}
+
+// String returns the names of the values in s, such as [A B].
+func (s SetLargeSet) String() string {
+	return "[" + strings.Join(s.names(), " ") + "]"
+}
+
+// MarshalText implements encoding.TextMarshaler, separating names by commas.
+func (s SetLargeSet) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(s.names(), ",")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SetLargeSet) UnmarshalText(b []byte) error {
+	var t SetLargeSet
+	for _, name := range strings.Split(string(b), ",") {
+		if name == "" {
+			continue
+		}
+		v, err := ParseSetLarge(name)
+		if err != nil {
+			return err
+		}
+		t.Add(v)
+	}
+	*s = t
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding s as an array of names.
+func (s SetLargeSet) MarshalJSON() ([]byte, error) {
+	names := s.names()
+	if names == nil {
+		names = []string{}
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SetLargeSet) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	var t SetLargeSet
+	for _, name := range names {
+		v, err := ParseSetLarge(name)
+		if err != nil {
+			return err
+		}
+		t.Add(v)
+	}
+	*s = t
+	return nil
+}
@@ -0,0 +1,14 @@
+package test
+
+type SetOps uint16
+
+const (
+	SetOpsNone SetOps = 0
+	SetOpsRead SetOps = 1 << iota
+	SetOpsWrite
+	SetOpsExecute
+
+	SetOpsSticky SetOps = 1 << 9
+
+	SetOpsAll = SetOpsRead | SetOpsWrite | SetOpsExecute
+)
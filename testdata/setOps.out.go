@@ -0,0 +1,131 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SetOpsNone-0]
+	_ = x[SetOpsRead-2]
+	_ = x[SetOpsWrite-4]
+	_ = x[SetOpsExecute-8]
+	_ = x[SetOpsSticky-512]
+}
+
+const (
+	_SetOps_name_0 = "NoneReadWriteExecute"
+	_SetOps_name_1 = "Sticky"
+)
+
+var (
+	_SetOps_index_0 = [...]uint8{0, 4, 8, 13, 20}
+)
+
+func (i SetOps) AppendFlags(s []string) []string {
+	if i == 0 {
+		return append(s, _SetOps_name_0[_SetOps_index_0[0]:_SetOps_index_0[1]])
+	}
+
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _SetOps_name_0[_SetOps_index_0[1]:_SetOps_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _SetOps_name_0[_SetOps_index_0[2]:_SetOps_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _SetOps_name_0[_SetOps_index_0[3]:_SetOps_index_0[4]])
+	}
+	if i&512 != 0 {
+		i, s = i&^512, append(s, _SetOps_name_1)
+	}
+	if i != 0 {
+		s = append(s, "SetOps("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i SetOps) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount16(uint16(i))))
+}
+
+func (i SetOps) AppendText(b []byte) ([]byte, error) {
+	if i == 0 {
+		return append(b, _SetOps_name_0[_SetOps_index_0[0]:_SetOps_index_0[1]]...), nil
+	}
+
+	n := len(b)
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _SetOps_name_0[_SetOps_index_0[1]:_SetOps_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _SetOps_name_0[_SetOps_index_0[2]:_SetOps_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _SetOps_name_0[_SetOps_index_0[3]:_SetOps_index_0[4]]...)
+	}
+	if i&512 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^512, append(b, _SetOps_name_1...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "SetOps("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i SetOps) String() string {
+	var buf [60]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+const _SetOps_mask SetOps = 0x20e
+
+// Has reports whether the flags of f are set in i. Unlike HasAll(0),
+// which is always true, Has(0) reports whether no flag is set.
+func (i SetOps) Has(f SetOps) bool {
+	if f == 0 {
+		return i == 0
+	}
+	return i&f == f
+}
+
+func (i SetOps) HasAny(f SetOps) bool { return i&f != 0 }
+
+func (i SetOps) HasAll(f SetOps) bool { return i&f == f }
+
+func (i SetOps) Union(f SetOps) SetOps { return i | f }
+
+func (i SetOps) Intersect(f SetOps) SetOps { return i & f }
+
+func (i SetOps) Without(f SetOps) SetOps { return i &^ f }
+
+func (i SetOps) Toggle(f SetOps) SetOps { return i ^ f }
+
+func (i SetOps) Count() int { return bits.OnesCount16(uint16(i)) }
+
+func (i SetOps) IsZero() bool { return i == 0 }
+
+func (i SetOps) Known() SetOps { return i & _SetOps_mask }
+
+func (i SetOps) Unknown() SetOps { return i &^ _SetOps_mask }
@@ -0,0 +1,13 @@
+package test
+
+type SetOpsSigned int8
+
+const (
+	SetOpsSignedA SetOpsSigned = 1 << iota
+	SetOpsSignedB
+	SetOpsSignedC
+	SetOpsSignedD
+	SetOpsSignedE
+	SetOpsSignedF
+	SetOpsSignedG
+)
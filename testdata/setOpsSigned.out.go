@@ -0,0 +1,151 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SetOpsSignedA-1]
+	_ = x[SetOpsSignedB-2]
+	_ = x[SetOpsSignedC-4]
+	_ = x[SetOpsSignedD-8]
+	_ = x[SetOpsSignedE-16]
+	_ = x[SetOpsSignedF-32]
+	_ = x[SetOpsSignedG-64]
+}
+
+const (
+	_SetOpsSigned_name_0 = "ABCDEFG"
+)
+
+var (
+	_SetOpsSigned_index_0 = [...]uint8{0, 1, 2, 3, 4, 5, 6, 7}
+)
+
+func (i SetOpsSigned) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _SetOpsSigned_name_0[_SetOpsSigned_index_0[0]:_SetOpsSigned_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _SetOpsSigned_name_0[_SetOpsSigned_index_0[1]:_SetOpsSigned_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _SetOpsSigned_name_0[_SetOpsSigned_index_0[2]:_SetOpsSigned_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _SetOpsSigned_name_0[_SetOpsSigned_index_0[3]:_SetOpsSigned_index_0[4]])
+	}
+	if i&16 != 0 {
+		i, s = i&^16, append(s, _SetOpsSigned_name_0[_SetOpsSigned_index_0[4]:_SetOpsSigned_index_0[5]])
+	}
+	if i&32 != 0 {
+		i, s = i&^32, append(s, _SetOpsSigned_name_0[_SetOpsSigned_index_0[5]:_SetOpsSigned_index_0[6]])
+	}
+	if i&64 != 0 {
+		i, s = i&^64, append(s, _SetOpsSigned_name_0[_SetOpsSigned_index_0[6]:_SetOpsSigned_index_0[7]])
+	}
+	if i != 0 {
+		s = append(s, "SetOpsSigned("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i SetOpsSigned) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i SetOpsSigned) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _SetOpsSigned_name_0[_SetOpsSigned_index_0[0]:_SetOpsSigned_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _SetOpsSigned_name_0[_SetOpsSigned_index_0[1]:_SetOpsSigned_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _SetOpsSigned_name_0[_SetOpsSigned_index_0[2]:_SetOpsSigned_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _SetOpsSigned_name_0[_SetOpsSigned_index_0[3]:_SetOpsSigned_index_0[4]]...)
+	}
+	if i&16 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^16, append(b, _SetOpsSigned_name_0[_SetOpsSigned_index_0[4]:_SetOpsSigned_index_0[5]]...)
+	}
+	if i&32 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^32, append(b, _SetOpsSigned_name_0[_SetOpsSigned_index_0[5]:_SetOpsSigned_index_0[6]]...)
+	}
+	if i&64 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^64, append(b, _SetOpsSigned_name_0[_SetOpsSigned_index_0[6]:_SetOpsSigned_index_0[7]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "SetOpsSigned("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i SetOpsSigned) String() string {
+	var buf [49]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+const _SetOpsSigned_mask SetOpsSigned = 0x7f
+
+// Has reports whether the flags of f are set in i. Unlike HasAll(0),
+// which is always true, Has(0) reports whether no flag is set.
+func (i SetOpsSigned) Has(f SetOpsSigned) bool {
+	if f == 0 {
+		return i == 0
+	}
+	return i&f == f
+}
+
+func (i SetOpsSigned) HasAny(f SetOpsSigned) bool { return i&f != 0 }
+
+func (i SetOpsSigned) HasAll(f SetOpsSigned) bool { return i&f == f }
+
+func (i SetOpsSigned) Union(f SetOpsSigned) SetOpsSigned { return i | f }
+
+func (i SetOpsSigned) Intersect(f SetOpsSigned) SetOpsSigned { return i & f }
+
+func (i SetOpsSigned) Without(f SetOpsSigned) SetOpsSigned { return i &^ f }
+
+func (i SetOpsSigned) Toggle(f SetOpsSigned) SetOpsSigned { return i ^ f }
+
+func (i SetOpsSigned) Count() int { return bits.OnesCount8(uint8(i)) }
+
+func (i SetOpsSigned) IsZero() bool { return i == 0 }
+
+func (i SetOpsSigned) Known() SetOpsSigned { return i & _SetOpsSigned_mask }
+
+func (i SetOpsSigned) Unknown() SetOpsSigned { return i &^ _SetOpsSigned_mask }
@@ -0,0 +1,13 @@
+package test
+
+type SizeEnum uint8
+
+const (
+	SizeEnumLow SizeEnum = iota
+	SizeEnumMedium
+	SizeEnumHigh
+
+	SizeEnumCritical SizeEnum = 10
+
+	SizeEnumUnknown SizeEnum = 255
+)
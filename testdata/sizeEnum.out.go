@@ -0,0 +1,38 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SizeEnumLow-0]
+	_ = x[SizeEnumMedium-1]
+	_ = x[SizeEnumHigh-2]
+	_ = x[SizeEnumCritical-10]
+	_ = x[SizeEnumUnknown-255]
+}
+
+const _SizeEnum_name = "LowMediumHighCriticalUnknown"
+
+var _SizeEnum_values = [...]SizeEnum{0, 1, 2, 10, 255}
+
+var _SizeEnum_index = [...]uint8{0, 3, 9, 13, 21, 28}
+
+func (i SizeEnum) String() string {
+	lo, hi := 0, len(_SizeEnum_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _SizeEnum_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_SizeEnum_values) && _SizeEnum_values[lo] == i {
+		return _SizeEnum_name[_SizeEnum_index[lo]:_SizeEnum_index[lo+1]]
+	}
+	return "SizeEnum(" + strconv.FormatInt(int64(i), 10) + ")"
+}
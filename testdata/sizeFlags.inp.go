@@ -0,0 +1,11 @@
+package test
+
+type SizeFlags uint8
+
+const (
+	SizeFlagsLow SizeFlags = 1 << iota
+	SizeFlagsMedium
+	SizeFlagsHigh
+
+	SizeFlagsCritical SizeFlags = 1 << 7
+)
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SizeFlagsLow-1]
+	_ = x[SizeFlagsMedium-2]
+	_ = x[SizeFlagsHigh-4]
+	_ = x[SizeFlagsCritical-128]
+}
+
+const _SizeFlags_name = "LowMediumHighCritical"
+
+var _SizeFlags_values = [...]SizeFlags{1, 2, 4, 128}
+
+var _SizeFlags_index = [...]uint8{0, 3, 9, 13, 21}
+
+func (i SizeFlags) AppendFlags(s []string) []string {
+	for j, v := range _SizeFlags_values {
+		if i&v != 0 {
+			i, s = i&^v, append(s, _SizeFlags_name[_SizeFlags_index[j]:_SizeFlags_index[j+1]])
+		}
+	}
+	if i != 0 {
+		s = append(s, "SizeFlags("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i SizeFlags) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i SizeFlags) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	for j, v := range _SizeFlags_values {
+		if i&v != 0 {
+			if len(b) > n {
+				b = append(b, '+')
+			}
+			i, b = i&^v, append(b, _SizeFlags_name[_SizeFlags_index[j]:_SizeFlags_index[j+1]]...)
+		}
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "SizeFlags("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i SizeFlags) String() string {
+	var buf [57]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
@@ -0,0 +1,25 @@
+package test
+
+type Skip uint
+
+const (
+	SkipRead Skip = 1 << iota
+	SkipWrite
+)
+
+// ActiveFlags returns the names of the flags that are set, lower-cased.
+func (i Skip) ActiveFlags() []string {
+	var s []string
+	if i&SkipRead != 0 {
+		s = append(s, "read")
+	}
+	if i&SkipWrite != 0 {
+		s = append(s, "write")
+	}
+	return s
+}
+
+// SetWrite is done in place.
+func (i *Skip) SetWrite() {
+	*i |= SkipWrite
+}
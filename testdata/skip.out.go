@@ -0,0 +1,72 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SkipRead-1]
+	_ = x[SkipWrite-2]
+}
+
+const (
+	_Skip_name_0 = "ReadWrite"
+)
+
+var (
+	_Skip_index_0 = [...]uint8{0, 4, 9}
+)
+
+func (i Skip) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _Skip_name_0[_Skip_index_0[0]:_Skip_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Skip_name_0[_Skip_index_0[1]:_Skip_index_0[2]])
+	}
+	if i != 0 {
+		s = append(s, "Skip("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Skip) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _Skip_name_0[_Skip_index_0[0]:_Skip_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Skip_name_0[_Skip_index_0[1]:_Skip_index_0[2]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Skip("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Skip) String() string {
+	var buf [38]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func (i Skip) Read() bool      { return i&SkipRead == SkipRead }
+func (i Skip) SetRead() Skip   { return i | SkipRead }
+func (i Skip) ClearRead() Skip { return i & ^SkipRead }
+
+func (i Skip) Write() bool      { return i&SkipWrite == SkipWrite }
+func (i Skip) ClearWrite() Skip { return i & ^SkipWrite }
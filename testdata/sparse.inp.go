@@ -0,0 +1,16 @@
+package test
+
+type Sparse uint32
+
+const (
+	SparseNone Sparse = 0
+	SparseA    Sparse = 1 << (2 * iota)
+	SparseB
+	SparseC
+	SparseD
+	SparseE
+	SparseF
+	SparseG
+	SparseH
+	SparseI
+)
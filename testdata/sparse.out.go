@@ -0,0 +1,79 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SparseNone-0]
+	_ = x[SparseA-4]
+	_ = x[SparseB-16]
+	_ = x[SparseC-64]
+	_ = x[SparseD-256]
+	_ = x[SparseE-1024]
+	_ = x[SparseF-4096]
+	_ = x[SparseG-16384]
+	_ = x[SparseH-65536]
+	_ = x[SparseI-262144]
+}
+
+const _Sparse_name = "NoneABCDEFGHI"
+
+var _Sparse_values = [...]Sparse{0, 4, 16, 64, 256, 1024, 4096, 16384, 65536, 262144}
+
+var _Sparse_index = [...]uint8{0, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}
+
+func (i Sparse) AppendFlags(s []string) []string {
+	if i == 0 {
+		return append(s, _Sparse_name[0:4])
+	}
+
+	for j, v := range _Sparse_values {
+		if i&v != 0 {
+			i, s = i&^v, append(s, _Sparse_name[_Sparse_index[j]:_Sparse_index[j+1]])
+		}
+	}
+	if i != 0 {
+		s = append(s, "Sparse("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Sparse) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount32(uint32(i))))
+}
+
+func (i Sparse) AppendText(b []byte) ([]byte, error) {
+	if i == 0 {
+		return append(b, _Sparse_name[0:4]...), nil
+	}
+
+	n := len(b)
+	for j, v := range _Sparse_values {
+		if i&v != 0 {
+			if len(b) > n {
+				b = append(b, '+')
+			}
+			i, b = i&^v, append(b, _Sparse_name[_Sparse_index[j]:_Sparse_index[j+1]]...)
+		}
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Sparse("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Sparse) String() string {
+	var buf [52]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
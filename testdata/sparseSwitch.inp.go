@@ -0,0 +1,16 @@
+package test
+
+type SparseSwitch uint32
+
+const (
+	SparseSwitchNone SparseSwitch = 0
+	SparseSwitchA    SparseSwitch = 1 << (2 * iota)
+	SparseSwitchB
+	SparseSwitchC
+	SparseSwitchD
+	SparseSwitchE
+	SparseSwitchF
+	SparseSwitchG
+	SparseSwitchH
+	SparseSwitchI
+)
@@ -0,0 +1,157 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SparseSwitchNone-0]
+	_ = x[SparseSwitchA-4]
+	_ = x[SparseSwitchB-16]
+	_ = x[SparseSwitchC-64]
+	_ = x[SparseSwitchD-256]
+	_ = x[SparseSwitchE-1024]
+	_ = x[SparseSwitchF-4096]
+	_ = x[SparseSwitchG-16384]
+	_ = x[SparseSwitchH-65536]
+	_ = x[SparseSwitchI-262144]
+}
+
+const (
+	_SparseSwitch_name_0 = "NoneA"
+	_SparseSwitch_name_1 = "B"
+	_SparseSwitch_name_2 = "C"
+	_SparseSwitch_name_3 = "D"
+	_SparseSwitch_name_4 = "E"
+	_SparseSwitch_name_5 = "F"
+	_SparseSwitch_name_6 = "G"
+	_SparseSwitch_name_7 = "H"
+	_SparseSwitch_name_8 = "I"
+)
+
+var (
+	_SparseSwitch_index_0 = [...]uint8{0, 4, 5}
+)
+
+func (i SparseSwitch) AppendFlags(s []string) []string {
+	if i == 0 {
+		return append(s, _SparseSwitch_name_0[_SparseSwitch_index_0[0]:_SparseSwitch_index_0[1]])
+	}
+
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _SparseSwitch_name_0[_SparseSwitch_index_0[1]:_SparseSwitch_index_0[2]])
+	}
+	if i&16 != 0 {
+		i, s = i&^16, append(s, _SparseSwitch_name_1)
+	}
+	if i&64 != 0 {
+		i, s = i&^64, append(s, _SparseSwitch_name_2)
+	}
+	if i&256 != 0 {
+		i, s = i&^256, append(s, _SparseSwitch_name_3)
+	}
+	if i&1024 != 0 {
+		i, s = i&^1024, append(s, _SparseSwitch_name_4)
+	}
+	if i&4096 != 0 {
+		i, s = i&^4096, append(s, _SparseSwitch_name_5)
+	}
+	if i&16384 != 0 {
+		i, s = i&^16384, append(s, _SparseSwitch_name_6)
+	}
+	if i&65536 != 0 {
+		i, s = i&^65536, append(s, _SparseSwitch_name_7)
+	}
+	if i&262144 != 0 {
+		i, s = i&^262144, append(s, _SparseSwitch_name_8)
+	}
+	if i != 0 {
+		s = append(s, "SparseSwitch("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i SparseSwitch) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount32(uint32(i))))
+}
+
+func (i SparseSwitch) AppendText(b []byte) ([]byte, error) {
+	if i == 0 {
+		return append(b, _SparseSwitch_name_0[_SparseSwitch_index_0[0]:_SparseSwitch_index_0[1]]...), nil
+	}
+
+	n := len(b)
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _SparseSwitch_name_0[_SparseSwitch_index_0[1]:_SparseSwitch_index_0[2]]...)
+	}
+	if i&16 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^16, append(b, _SparseSwitch_name_1...)
+	}
+	if i&64 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^64, append(b, _SparseSwitch_name_2...)
+	}
+	if i&256 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^256, append(b, _SparseSwitch_name_3...)
+	}
+	if i&1024 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1024, append(b, _SparseSwitch_name_4...)
+	}
+	if i&4096 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4096, append(b, _SparseSwitch_name_5...)
+	}
+	if i&16384 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^16384, append(b, _SparseSwitch_name_6...)
+	}
+	if i&65536 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^65536, append(b, _SparseSwitch_name_7...)
+	}
+	if i&262144 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^262144, append(b, _SparseSwitch_name_8...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "SparseSwitch("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i SparseSwitch) String() string {
+	var buf [58]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
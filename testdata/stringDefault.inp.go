@@ -0,0 +1,9 @@
+package test
+
+type StringDefault uint8
+
+const (
+	StringDefaultLow  StringDefault = 1
+	StringDefaultMid  StringDefault = 5
+	StringDefaultHigh StringDefault = 9 //stringer:default
+)
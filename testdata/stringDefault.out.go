@@ -0,0 +1,32 @@
+package test
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[StringDefaultLow-1]
+	_ = x[StringDefaultMid-5]
+	_ = x[StringDefaultHigh-9]
+}
+
+const _StringDefault_name = "LowMidHigh"
+
+var _StringDefault_values = [...]StringDefault{1, 5, 9}
+
+var _StringDefault_index = [...]uint8{0, 3, 6, 10}
+
+func (i StringDefault) String() string {
+	lo, hi := 0, len(_StringDefault_values)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if _StringDefault_values[m] < i {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(_StringDefault_values) && _StringDefault_values[lo] == i {
+		return _StringDefault_name[_StringDefault_index[lo]:_StringDefault_index[lo+1]]
+	}
+	return "High"
+}
@@ -0,0 +1,10 @@
+package test
+
+type Transform int
+
+const (
+	TransformOK Transform = iota
+	TransformNotFound
+	TransformHTTPVersionNotSupported
+	TransformCustom // left alone
+)
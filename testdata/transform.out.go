@@ -0,0 +1,65 @@
+package test
+
+import (
+	"strconv"
+
+	"github.com/0x5a17ed/stringer/enum"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TransformOK-0]
+	_ = x[TransformNotFound-1]
+	_ = x[TransformHTTPVersionNotSupported-2]
+	_ = x[TransformCustom-3]
+}
+
+const _Transform_name = "oknot-foundhttp-version-not-supportedleft alone"
+
+var _Transform_index = [...]uint8{0, 2, 11, 37, 47}
+
+func (i Transform) String() string {
+	if i < 0 || i >= Transform(len(_Transform_index)-1) {
+		return "Transform(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Transform_name[_Transform_index[i]:_Transform_index[i+1]]
+}
+
+func _Transform_lookup(s string) (Transform, bool) {
+	switch len(s) {
+	case 2:
+		if s == _Transform_name[0:2] {
+			return TransformOK, true
+		}
+	case 9:
+		if s == _Transform_name[2:11] {
+			return TransformNotFound, true
+		}
+	case 10:
+		if s == _Transform_name[37:47] {
+			return TransformCustom, true
+		}
+	case 26:
+		if s == _Transform_name[11:37] {
+			return TransformHTTPVersionNotSupported, true
+		}
+	}
+	return 0, false
+}
+
+var _Transform_names = [...]string{
+	_Transform_name[0:2],
+	_Transform_name[2:11],
+	_Transform_name[11:37],
+	_Transform_name[37:47],
+}
+
+// ParseTransform returns the Transform named s.
+func ParseTransform(s string) (Transform, error) {
+	if i, ok := _Transform_lookup(s); ok {
+		return i, nil
+	}
+	return 0, &enum.ParseError{Type: "Transform", Input: s, Valid: append([]string(nil), _Transform_names[:]...), Err: enum.ErrUnknownName}
+}
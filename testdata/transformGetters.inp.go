@@ -0,0 +1,9 @@
+package test
+
+type TransformGetters uint8
+
+const (
+	TransformGettersReadOnly TransformGetters = 1 << iota
+	TransformGettersWriteAll
+	TransformGettersExecute //stringer:name "exec"
+)
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TransformGettersReadOnly-1]
+	_ = x[TransformGettersWriteAll-2]
+	_ = x[TransformGettersExecute-4]
+}
+
+const (
+	_TransformGetters_name_0 = "read-onlywrite-allexec"
+)
+
+var (
+	_TransformGetters_index_0 = [...]uint8{0, 9, 18, 22}
+)
+
+func (i TransformGetters) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _TransformGetters_name_0[_TransformGetters_index_0[0]:_TransformGetters_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _TransformGetters_name_0[_TransformGetters_index_0[1]:_TransformGetters_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _TransformGetters_name_0[_TransformGetters_index_0[2]:_TransformGetters_index_0[3]])
+	}
+	if i != 0 {
+		s = append(s, "TransformGetters("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i TransformGetters) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount8(uint8(i))))
+}
+
+func (i TransformGetters) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _TransformGetters_name_0[_TransformGetters_index_0[0]:_TransformGetters_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _TransformGetters_name_0[_TransformGetters_index_0[1]:_TransformGetters_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _TransformGetters_name_0[_TransformGetters_index_0[2]:_TransformGetters_index_0[3]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "TransformGetters("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i TransformGetters) String() string {
+	var buf [64]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
+
+func (i TransformGetters) ReadOnly() bool {
+	return i&TransformGettersReadOnly == TransformGettersReadOnly
+}
+func (i TransformGetters) SetReadOnly() TransformGetters   { return i | TransformGettersReadOnly }
+func (i TransformGetters) ClearReadOnly() TransformGetters { return i & ^TransformGettersReadOnly }
+
+func (i TransformGetters) WriteAll() bool {
+	return i&TransformGettersWriteAll == TransformGettersWriteAll
+}
+func (i TransformGetters) SetWriteAll() TransformGetters   { return i | TransformGettersWriteAll }
+func (i TransformGetters) ClearWriteAll() TransformGetters { return i & ^TransformGettersWriteAll }
+
+func (i TransformGetters) Execute() bool                  { return i&TransformGettersExecute == TransformGettersExecute }
+func (i TransformGetters) SetExecute() TransformGetters   { return i | TransformGettersExecute }
+func (i TransformGetters) ClearExecute() TransformGetters { return i & ^TransformGettersExecute }
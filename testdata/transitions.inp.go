@@ -0,0 +1,12 @@
+package test
+
+type Transitions int
+
+const (
+	TransitionsPending Transitions = iota //stringer:transitions Running, Cancelled
+	TransitionsRunning                    //stringer:transitions TransitionsSucceeded,Failed
+	TransitionsFailed                     //stringer:transitions Pending
+	TransitionsSucceeded
+	TransitionsCancelled
+	TransitionsArchived
+)
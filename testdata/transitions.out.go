@@ -0,0 +1,63 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TransitionsPending-0]
+	_ = x[TransitionsRunning-1]
+	_ = x[TransitionsFailed-2]
+	_ = x[TransitionsSucceeded-3]
+	_ = x[TransitionsCancelled-4]
+	_ = x[TransitionsArchived-5]
+}
+
+const _Transitions_name = "PendingRunningFailedSucceededCancelledArchived"
+
+var _Transitions_index = [...]uint8{0, 7, 14, 20, 29, 38, 46}
+
+func (i Transitions) String() string {
+	if i < 0 || i >= Transitions(len(_Transitions_index)-1) {
+		return "Transitions(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Transitions_name[_Transitions_index[i]:_Transitions_index[i+1]]
+}
+
+// CanTransitionTo reports whether i can transition to the state to.
+func (i Transitions) CanTransitionTo(to Transitions) bool {
+	switch i {
+	case TransitionsPending:
+		return to == TransitionsRunning || to == TransitionsCancelled
+	case TransitionsRunning:
+		return to == TransitionsSucceeded || to == TransitionsFailed
+	case TransitionsFailed:
+		return to == TransitionsPending
+	}
+	return false
+}
+
+// Transitions returns the states i can transition to.
+func (i Transitions) Transitions() []Transitions {
+	switch i {
+	case TransitionsPending:
+		return []Transitions{TransitionsRunning, TransitionsCancelled}
+	case TransitionsRunning:
+		return []Transitions{TransitionsSucceeded, TransitionsFailed}
+	case TransitionsFailed:
+		return []Transitions{TransitionsPending}
+	}
+	return nil
+}
+
+// IsTerminal reports whether i is a state without transitions.
+func (i Transitions) IsTerminal() bool {
+	switch i {
+	case TransitionsSucceeded, TransitionsCancelled, TransitionsArchived:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,14 @@
+package test
+
+type TransitionsAlias int
+
+const (
+	TransitionsAliasNew    TransitionsAlias = iota //stringer:transitions Open
+	TransitionsAliasOpen                           //stringer:transitions Closed, Done
+	TransitionsAliasClosed                         //stringer:transitions Open
+
+	// TransitionsAliasDone is what Closed used to be called.
+	TransitionsAliasDone TransitionsAlias = TransitionsAliasClosed //stringer:transitions Reopened
+	// TransitionsAliasReopened is what Open is called when coming back.
+	TransitionsAliasReopened TransitionsAlias = TransitionsAliasOpen //stringer:transitions Closed
+)
@@ -0,0 +1,58 @@
+package test
+
+import (
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TransitionsAliasNew-0]
+	_ = x[TransitionsAliasOpen-1]
+	_ = x[TransitionsAliasClosed-2]
+	_ = x[TransitionsAliasDone-2]
+	_ = x[TransitionsAliasReopened-1]
+}
+
+const _TransitionsAlias_name = "NewOpenClosed"
+
+var _TransitionsAlias_index = [...]uint8{0, 3, 7, 13}
+
+func (i TransitionsAlias) String() string {
+	if i < 0 || i >= TransitionsAlias(len(_TransitionsAlias_index)-1) {
+		return "TransitionsAlias(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _TransitionsAlias_name[_TransitionsAlias_index[i]:_TransitionsAlias_index[i+1]]
+}
+
+// CanTransitionTo reports whether i can transition to the state to.
+func (i TransitionsAlias) CanTransitionTo(to TransitionsAlias) bool {
+	switch i {
+	case TransitionsAliasNew:
+		return to == TransitionsAliasOpen
+	case TransitionsAliasOpen:
+		return to == TransitionsAliasClosed
+	case TransitionsAliasClosed:
+		return to == TransitionsAliasOpen
+	}
+	return false
+}
+
+// Transitions returns the states i can transition to.
+func (i TransitionsAlias) Transitions() []TransitionsAlias {
+	switch i {
+	case TransitionsAliasNew:
+		return []TransitionsAlias{TransitionsAliasOpen}
+	case TransitionsAliasOpen:
+		return []TransitionsAlias{TransitionsAliasClosed}
+	case TransitionsAliasClosed:
+		return []TransitionsAlias{TransitionsAliasOpen}
+	}
+	return nil
+}
+
+// IsTerminal reports whether i is a state without transitions.
+func (i TransitionsAlias) IsTerminal() bool {
+	return false
+}
@@ -0,0 +1,9 @@
+package test
+
+type Trimmed uint
+
+const (
+	TrimmedRed Trimmed = 1 << iota
+	TrimmedGreen
+	TrimmedBlue
+)
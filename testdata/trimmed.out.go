@@ -0,0 +1,80 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TrimmedRed-1]
+	_ = x[TrimmedGreen-2]
+	_ = x[TrimmedBlue-4]
+}
+
+const (
+	_Trimmed_name_0 = "RedGreenBlue"
+)
+
+var (
+	_Trimmed_index_0 = [...]uint8{0, 3, 8, 12}
+)
+
+func (i Trimmed) AppendFlags(s []string) []string {
+	if i&1 != 0 {
+		i, s = i&^1, append(s, _Trimmed_name_0[_Trimmed_index_0[0]:_Trimmed_index_0[1]])
+	}
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Trimmed_name_0[_Trimmed_index_0[1]:_Trimmed_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Trimmed_name_0[_Trimmed_index_0[2]:_Trimmed_index_0[3]])
+	}
+	if i != 0 {
+		s = append(s, "Trimmed("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Trimmed) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount(uint(i))))
+}
+
+func (i Trimmed) AppendText(b []byte) ([]byte, error) {
+	n := len(b)
+	if i&1 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^1, append(b, _Trimmed_name_0[_Trimmed_index_0[0]:_Trimmed_index_0[1]]...)
+	}
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Trimmed_name_0[_Trimmed_index_0[1]:_Trimmed_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Trimmed_name_0[_Trimmed_index_0[2]:_Trimmed_index_0[3]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Trimmed("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Trimmed) String() string {
+	var buf [45]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
@@ -0,0 +1,10 @@
+package test
+
+type Zero uint
+
+const (
+	None Zero = 0
+	One  Zero = 1 << iota
+	Two
+	Three
+)
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[None-0]
+	_ = x[One-2]
+	_ = x[Two-4]
+	_ = x[Three-8]
+}
+
+const (
+	_Zero_name_0 = "NoneOneTwoThree"
+)
+
+var (
+	_Zero_index_0 = [...]uint8{0, 4, 7, 10, 15}
+)
+
+func (i Zero) AppendFlags(s []string) []string {
+	if i == 0 {
+		return append(s, _Zero_name_0[_Zero_index_0[0]:_Zero_index_0[1]])
+	}
+
+	if i&2 != 0 {
+		i, s = i&^2, append(s, _Zero_name_0[_Zero_index_0[1]:_Zero_index_0[2]])
+	}
+	if i&4 != 0 {
+		i, s = i&^4, append(s, _Zero_name_0[_Zero_index_0[2]:_Zero_index_0[3]])
+	}
+	if i&8 != 0 {
+		i, s = i&^8, append(s, _Zero_name_0[_Zero_index_0[3]:_Zero_index_0[4]])
+	}
+	if i != 0 {
+		s = append(s, "Zero("+strconv.FormatInt(int64(i), 10)+")")
+	}
+	return s
+}
+
+func (i Zero) ActiveFlags() []string {
+	return i.AppendFlags(make([]string, 0, bits.OnesCount(uint(i))))
+}
+
+func (i Zero) AppendText(b []byte) ([]byte, error) {
+	if i == 0 {
+		return append(b, _Zero_name_0[_Zero_index_0[0]:_Zero_index_0[1]]...), nil
+	}
+
+	n := len(b)
+	if i&2 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^2, append(b, _Zero_name_0[_Zero_index_0[1]:_Zero_index_0[2]]...)
+	}
+	if i&4 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^4, append(b, _Zero_name_0[_Zero_index_0[2]:_Zero_index_0[3]]...)
+	}
+	if i&8 != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		i, b = i&^8, append(b, _Zero_name_0[_Zero_index_0[3]:_Zero_index_0[4]]...)
+	}
+	if i != 0 {
+		if len(b) > n {
+			b = append(b, '+')
+		}
+		b = append(b, "Zero("...)
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, ')')
+	}
+	return b, nil
+}
+
+func (i Zero) String() string {
+	var buf [46]byte
+	b, _ := i.AppendText(buf[:0])
+	return string(b)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Transform selects how the trimmed constant names are turned into the
+// names of the values.
+type Transform int
+
+const (
+	TransformNone  Transform = iota // Keep the trimmed name: NotFound.
+	TransformSnake                  // Lower-case words joined by underscores: not_found.
+	TransformKebab                  // Lower-case words joined by dashes: not-found.
+	TransformLower                  // The name in lower case: notfound.
+	TransformUpper                  // The name in upper case: NOTFOUND.
+	TransformTitle                  // Capitalized words joined by spaces: Not Found.
+	TransformWords                  // Lower-case words joined by spaces: not found.
+)
+
+// apply returns the name transformed.
+func (t Transform) apply(name string) string {
+	switch t {
+	case TransformSnake:
+		return strings.ToLower(strings.Join(splitWords(name), "_"))
+	case TransformKebab:
+		return strings.ToLower(strings.Join(splitWords(name), "-"))
+	case TransformLower:
+		return strings.ToLower(name)
+	case TransformUpper:
+		return strings.ToUpper(name)
+	case TransformTitle:
+		// Acronyms such as HTTP are kept unless the whole name is in
+		// upper case, as in NOT_FOUND.
+		shouting := strings.ToUpper(name) == name
+		words := splitWords(name)
+		for i, w := range words {
+			r, n := utf8.DecodeRuneInString(w)
+			rest := w[n:]
+			if shouting {
+				rest = strings.ToLower(rest)
+			}
+			words[i] = string(unicode.ToUpper(r)) + rest
+		}
+		return strings.Join(words, " ")
+	case TransformWords:
+		return strings.ToLower(strings.Join(splitWords(name), " "))
+	default:
+		return name
+	}
+}
+
+// splitWords splits a MixedCaps or underscore separated name into words.
+// A word starts at an upper-case letter following a lower-case letter or
+// digit, and at the last letter of a run of upper-case letters followed by
+// a lower-case one, so that HTTPServer2Go is made of HTTP, Server2 and Go.
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ':
+			if i > start {
+				words = append(words, string(runes[start:i]))
+			}
+			start = i + 1
+			continue
+		case i == start || !unicode.IsUpper(r):
+			continue
+		}
+
+		prev := runes[i-1]
+		acronymEnd := unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if unicode.IsLower(prev) || unicode.IsDigit(prev) || acronymEnd {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
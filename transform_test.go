@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTransform(t *testing.T) {
+	tt := []struct {
+		name      string
+		transform Transform
+		want      string
+	}{
+		{"NotFound", TransformNone, "NotFound"},
+		{"NotFound", TransformSnake, "not_found"},
+		{"NotFound", TransformKebab, "not-found"},
+		{"NotFound", TransformLower, "notfound"},
+		{"NotFound", TransformUpper, "NOTFOUND"},
+		{"NotFound", TransformTitle, "Not Found"},
+		{"NotFound", TransformWords, "not found"},
+		{"HTTPVersion", TransformSnake, "http_version"},
+		{"HTTPVersion", TransformTitle, "HTTP Version"},
+		{"NOT_FOUND", TransformKebab, "not-found"},
+		{"NOT_FOUND", TransformTitle, "Not Found"},
+		{"Base64Data", TransformSnake, "base64_data"},
+		{"OK", TransformSnake, "ok"},
+		{"_Hidden", TransformWords, "hidden"},
+	}
+	for _, tc := range tt {
+		assert.Equal(t, tc.transform.apply(tc.name), tc.want, "%s", tc.name)
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	assert.DeepEqual(t, splitWords("HTTPServer2Go"), []string{"HTTP", "Server2", "Go"})
+	assert.DeepEqual(t, splitWords("already_snake_case"), []string{"already", "snake", "case"})
+	assert.DeepEqual(t, splitWords("getURL"), []string{"get", "URL"})
+	assert.Assert(t, splitWords("") == nil)
+}
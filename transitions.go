@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"log"
+	"slices"
+	"strings"
+)
+
+// applyTransitions records the states named by a stringer:transitions
+// directive, a comma-separated list of constants.
+func (v *Value) applyTransitions(args string) error {
+	for name := range strings.SplitSeq(args, ",") {
+		name = strings.TrimSpace(name)
+		if !token.IsIdentifier(name) {
+			return fmt.Errorf("stringer:transitions takes a comma-separated list of constants: %q", args)
+		}
+		v.transitions = append(v.transitions, name)
+	}
+	return nil
+}
+
+// graph is the state machine given by the transitions of a type.
+type graph struct {
+	typeName string
+	states   []*Value
+	edges    map[*Value][]*Value // Target states by source state.
+}
+
+// hasTransitions reports whether any of the constants has transitions.
+func hasTransitions(constants []Value) bool {
+	for _, v := range constants {
+		if len(v.transitions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// transitionGraph returns the graph of the transitions between the values
+// of runs, given by the directives of the constants. The states are named
+// by constant, with or without the prefix trimmed from the names, and
+// constants sharing a value stand for the same state, which has the
+// transitions of all of them. Undefined states are fatal, and states which
+// cannot be reached from the lowest value are reported.
+func transitionGraph(typeName, trimPrefix string, constants []Value, runs [][]Value) *graph {
+	gr := &graph{typeName: typeName, edges: make(map[*Value][]*Value)}
+	byValue := make(map[uint64]*Value)
+	for _, values := range runs {
+		for j := range values {
+			gr.states = append(gr.states, &values[j])
+			byValue[values[j].value] = &values[j]
+		}
+	}
+	byName := make(map[string]*Value)
+	for _, c := range constants {
+		byName[c.originalName] = byValue[c.value]
+	}
+
+	for _, c := range constants {
+		from := byValue[c.value]
+		for _, name := range c.transitions {
+			to, ok := byName[name]
+			if !ok {
+				to, ok = byName[trimPrefix+name]
+			}
+			if !ok {
+				log.Fatalf("type %s: %s transitions to undefined state %s", typeName, c.originalName, name)
+			}
+			if !slices.Contains(gr.edges[from], to) {
+				gr.edges[from] = append(gr.edges[from], to)
+			}
+		}
+	}
+
+	reached := map[*Value]bool{gr.states[0]: true}
+	for queue := []*Value{gr.states[0]}; len(queue) > 0; queue = queue[1:] {
+		for _, to := range gr.edges[queue[0]] {
+			if !reached[to] {
+				reached[to] = true
+				queue = append(queue, to)
+			}
+		}
+	}
+	for _, v := range gr.states {
+		if !reached[v] {
+			log.Printf("warning: type %s: state %s cannot be reached from %s", typeName, v.originalName, gr.states[0].originalName)
+		}
+	}
+	return gr
+}
+
+// buildTransitions generates the methods telling the transitions allowed
+// between states, as given by stringer:transitions directives, and records
+// the graph to be written out.
+func (g *Generator) buildTransitions(typeName string, kind Kind, trimPrefix string, constants []Value, runs [][]Value) {
+	if !hasTransitions(constants) {
+		return
+	}
+	if kind == Flag {
+		log.Fatalf("type %s: transitions are only supported for enums", typeName)
+	}
+	gr := transitionGraph(typeName, trimPrefix, constants, runs)
+	g.graphs = append(g.graphs, gr)
+
+	if name := g.method(typeName, "CanTransitionTo"); name != "" {
+		g.Printf("\n// %s reports whether i can transition to the state to.\n", name)
+		g.Printf("func (i %s) %s(to %s) bool {\n", typeName, name, typeName)
+		g.Printf("	switch i {\n")
+		for _, from := range gr.states {
+			if len(gr.edges[from]) == 0 {
+				continue
+			}
+			var conds []string
+			for _, to := range gr.edges[from] {
+				conds = append(conds, "to == "+to.originalName)
+			}
+			g.Printf("	case %s:\n", from.originalName)
+			g.Printf("		return %s\n", strings.Join(conds, " || "))
+		}
+		g.Printf("	}\n")
+		g.Printf("	return false\n")
+		g.Printf("}\n")
+	}
+
+	if name := g.method(typeName, "Transitions"); name != "" {
+		g.Printf("\n// %s returns the states i can transition to.\n", name)
+		g.Printf("func (i %s) %s() []%s {\n", typeName, name, typeName)
+		g.Printf("	switch i {\n")
+		for _, from := range gr.states {
+			if len(gr.edges[from]) == 0 {
+				continue
+			}
+			var names []string
+			for _, to := range gr.edges[from] {
+				names = append(names, to.originalName)
+			}
+			g.Printf("	case %s:\n", from.originalName)
+			g.Printf("		return []%s{%s}\n", typeName, strings.Join(names, ", "))
+		}
+		g.Printf("	}\n")
+		g.Printf("	return nil\n")
+		g.Printf("}\n")
+	}
+
+	if name := g.method(typeName, "IsTerminal"); name != "" {
+		var terminal []string
+		for _, v := range gr.states {
+			if len(gr.edges[v]) == 0 {
+				terminal = append(terminal, v.originalName)
+			}
+		}
+		g.Printf("\n// %s reports whether i is a state without transitions.\n", name)
+		g.Printf("func (i %s) %s() bool {\n", typeName, name)
+		if len(terminal) == 0 {
+			g.Printf("	return false\n")
+		} else {
+			g.Printf("	switch i {\n")
+			g.Printf("	case %s:\n", strings.Join(terminal, ", "))
+			g.Printf("		return true\n")
+			g.Printf("	}\n")
+			g.Printf("	return false\n")
+		}
+		g.Printf("}\n")
+	}
+}
+
+// dot returns the graphs in the DOT language of Graphviz, one digraph per
+// type. Terminal states are drawn with a double circle.
+func dot(graphs []*graph) []byte {
+	var b bytes.Buffer
+	for i, gr := range graphs {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "digraph %s {\n", gr.typeName)
+		for _, v := range gr.states {
+			shape := "circle"
+			if len(gr.edges[v]) == 0 {
+				shape = "doublecircle"
+			}
+			fmt.Fprintf(&b, "\t%s [label=%q, shape=%s];\n", v.originalName, v.name, shape)
+		}
+		for _, from := range gr.states {
+			for _, to := range gr.edges[from] {
+				fmt.Fprintf(&b, "\t%s -> %s;\n", from.originalName, to.originalName)
+			}
+		}
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+// mermaid returns the graphs as a Mermaid state diagram, holding one
+// composite state per type. The lowest value is the initial state.
+func mermaid(graphs []*graph) []byte {
+	var b bytes.Buffer
+	b.WriteString("stateDiagram-v2\n")
+	for _, gr := range graphs {
+		fmt.Fprintf(&b, "\tstate %s {\n", gr.typeName)
+		for _, v := range gr.states {
+			fmt.Fprintf(&b, "\t\t%s : %s\n", v.originalName, v.name)
+		}
+		fmt.Fprintf(&b, "\t\t[*] --> %s\n", gr.states[0].originalName)
+		for _, from := range gr.states {
+			for _, to := range gr.edges[from] {
+				fmt.Fprintf(&b, "\t\t%s --> %s\n", from.originalName, to.originalName)
+			}
+			if len(gr.edges[from]) == 0 {
+				fmt.Fprintf(&b, "\t\t%s --> [*]\n", from.originalName)
+			}
+		}
+		b.WriteString("\t}\n")
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTransitions(t *testing.T) {
+	var v Value
+	assert.NilError(t, directive{name: "transitions", args: "Running, Failed"}.apply(&v))
+	assert.DeepEqual(t, v.transitions, []string{"Running", "Failed"})
+
+	err := directive{name: "transitions", args: "Running,"}.apply(&v)
+	assert.ErrorContains(t, err, "comma-separated list of constants")
+}